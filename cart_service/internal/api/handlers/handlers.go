@@ -5,9 +5,9 @@ import (
 	cartpb "cart_service/proto/gen"
 	"cart_service/utils"
 	"context"
-	"errors"
 
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type handler struct {
@@ -23,12 +23,14 @@ func NewHandler(service cartService.Service) *handler {
 }
 
 func (h *handler) AddToCart(ctx context.Context, req *cartpb.AddToCartRequest) (*cartpb.CartStandardResponse, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, utils.MapError(errors.New("missing authentication metadata"))
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(status.Error(codes.InvalidArgument, err.Error()))
 	}
-	emails := md.Get("x-user-email")
-	resp, err := h.service.AddToCart(ctx, emails[0], req)
+	idempotencyKey, err := utils.GetIdempotencyKey(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	resp, err := h.service.AddToCart(ctx, utils.CartOwner(ctx), idempotencyKey, req)
 	if err != nil {
 		return nil, utils.MapError(err)
 	}
@@ -44,14 +46,10 @@ func (h *handler) AddToCart(ctx context.Context, req *cartpb.AddToCartRequest) (
 }
 
 func (h *handler) GetCart(ctx context.Context, req *cartpb.GetCartRequest) (*cartpb.CartStandardResponse, error) {
-
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, utils.MapError(errors.New("email not found in metadata"))
-
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(status.Error(codes.InvalidArgument, err.Error()))
 	}
-	emails := md.Get("x-user-email")
-	cart, err := h.service.GetCart(ctx, emails[0])
+	cart, err := h.service.GetCart(ctx, utils.CartOwner(ctx), req.ApplyUpdates)
 	if err != nil {
 		return nil, utils.MapError(err)
 
@@ -68,13 +66,10 @@ func (h *handler) GetCart(ctx context.Context, req *cartpb.GetCartRequest) (*car
 }
 
 func (h *handler) UpdateCartItem(ctx context.Context, req *cartpb.UpdateCartItemRequest) (*cartpb.CartStandardResponse, error) {
-
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, utils.MapError(errors.New("missing authentication metadata"))
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(status.Error(codes.InvalidArgument, err.Error()))
 	}
-	emails := md.Get("x-user-email")
-	resp, err := h.service.UpdateCart(ctx, emails[0], req)
+	resp, err := h.service.UpdateCart(ctx, utils.CartOwner(ctx), req)
 	if err != nil {
 		return nil, utils.MapError(err)
 	}
@@ -89,19 +84,73 @@ func (h *handler) UpdateCartItem(ctx context.Context, req *cartpb.UpdateCartItem
 }
 
 func (h *handler) RemoveFromCart(ctx context.Context, req *cartpb.RemoveFromCartRequest) (*cartpb.CartStandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	resp, err := h.service.Delete(ctx, utils.CartOwner(ctx), req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &cartpb.CartStandardResponse{
+		Success:    true,
+		Message:    "deleted successfully",
+		StatusCode: 200,
+		Result: &cartpb.CartStandardResponse_CartData{
+			CartData: resp,
+		},
+	}, nil
+}
+
+func (h *handler) ClearCart(ctx context.Context, req *cartpb.ClearCartRequest) (*cartpb.CartStandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	resp, err := h.service.ClearCart(ctx, utils.CartOwner(ctx), req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &cartpb.CartStandardResponse{
+		Success:    true,
+		Message:    "cart cleared successfully",
+		StatusCode: 200,
+		Result: &cartpb.CartStandardResponse_CartData{
+			CartData: resp,
+		},
+	}, nil
+}
+
+func (h *handler) CreateGuestSession(ctx context.Context, req *cartpb.CreateGuestSessionRequest) (*cartpb.CartStandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	session, err := h.service.CreateGuestSession(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &cartpb.CartStandardResponse{
+		Success:    true,
+		Message:    "guest session created successfully",
+		StatusCode: 201,
+		Result: &cartpb.CartStandardResponse_GuestSession{
+			GuestSession: session,
+		},
+	}, nil
+}
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, utils.MapError(errors.New("missing authentication metadata"))
+func (h *handler) MergeCart(ctx context.Context, req *cartpb.MergeCartRequest) (*cartpb.CartStandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(status.Error(codes.InvalidArgument, err.Error()))
 	}
-	emails := md.Get("x-user-email")
-	resp, err := h.service.Delete(ctx, emails[0], req)
+	resp, err := h.service.MergeCart(ctx, utils.CartOwner(ctx), req)
 	if err != nil {
 		return nil, utils.MapError(err)
 	}
 	return &cartpb.CartStandardResponse{
 		Success:    true,
-		Message:    resp,
+		Message:    "cart merged successfully",
 		StatusCode: 200,
+		Result: &cartpb.CartStandardResponse_CartData{
+			CartData: resp,
+		},
 	}, nil
 }
@@ -7,6 +7,8 @@ import (
 	"cart_service/internal/infra"
 	"cart_service/internal/interceptors"
 	cartRepo "cart_service/internal/repo/cart"
+	guestSessionRepo "cart_service/internal/repo/guestSession"
+	idempotencyRepo "cart_service/internal/repo/idempotency"
 	cartService "cart_service/internal/services/cart"
 	cartpb "cart_service/proto/gen"
 	"context"
@@ -25,7 +27,16 @@ type Application struct {
 
 func InitializeApp(ctx context.Context, cnf *config.Config) (*Application, error) {
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(interceptors.ErrorInterCeptor()))
+	rdb := infra.ConnectRedis("localhost:6379", 0)
+	log.Println("redis is connected")
+	sessions := guestSessionRepo.NewRepo(rdb)
+
+	// The error interceptor runs outermost so identity failures are returned
+	// in the standard response envelope too.
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptors.ErrorInterCeptor(),
+		interceptors.IdentityInterceptor(sessions),
+	))
 
 	lis, err := net.Listen("tcp", cnf.Addr)
 
@@ -33,15 +44,13 @@ func InitializeApp(ctx context.Context, cnf *config.Config) (*Application, error
 		return nil, err
 
 	}
-	rdb := infra.ConnectRedis("localhost:6379", 0)
-	log.Println("redis is connected")
 
 	productClient, closeProductClient, err := client.NewClient(ctx, cnf.User_Service_Addr)
 	if err != nil {
 		return nil, fmt.Errorf("dial user service: %w", err)
 	}
 	repo := cartRepo.NewRepo(rdb)
-	service := cartService.NewService(repo, productClient)
+	service := cartService.NewService(repo, idempotencyRepo.NewRepo(rdb), sessions, productClient, cnf.MergeStrategy)
 	handler := handlers.NewHandler(service)
 	cartpb.RegisterCartServiceServer(grpcServer, handler)
 
@@ -16,6 +16,8 @@ type client struct {
 
 type Client interface {
 	GetProductById(ctx context.Context, in *cartpb.GetProductByIdRequest) (*cartpb.Product, error)
+	// BatchGetProducts returns the products that exist among keys.
+	BatchGetProducts(ctx context.Context, keys []*cartpb.ProductKey) ([]*cartpb.Product, error)
 }
 
 func NewClient(ctx context.Context, clientAddr string) (Client, func() error, error) {
@@ -51,3 +53,27 @@ func (c *client) GetProductById(ctx context.Context, in *cartpb.GetProductByIdRe
 	}
 	return productData.Product, nil
 }
+
+// maxBatchKeys is the most keys the product service accepts per batch.
+const maxBatchKeys = 100
+
+func (c *client) BatchGetProducts(ctx context.Context, keys []*cartpb.ProductKey) ([]*cartpb.Product, error) {
+
+	products := make([]*cartpb.Product, 0, len(keys))
+	for start := 0; start < len(keys); start += maxBatchKeys {
+		end := min(start+maxBatchKeys, len(keys))
+		resp, err := c.stub.BatchGetProducts(ctx, &cartpb.BatchGetProductsRequest{Products: keys[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Success {
+			return nil, fmt.Errorf("failed to get products: %s", resp.Message)
+		}
+		batch := resp.GetBatchProducts()
+		if batch == nil {
+			return nil, fmt.Errorf("products not found in response")
+		}
+		products = append(products, batch.Products...)
+	}
+	return products, nil
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"cart_service/internal/domain"
 	"os"
 	"sync"
 
@@ -12,6 +13,8 @@ type Config struct {
 	ServiceName       string
 	Addr              string
 	User_Service_Addr string
+	// MergeStrategy is used by MergeCart when the request names none.
+	MergeStrategy string
 }
 
 var (
@@ -25,12 +28,17 @@ func loadConfig() {
 	serviceName := os.Getenv("SERVICE_NAME")
 	addr := os.Getenv("ADDR")
 	user_service_addr := os.Getenv("USER_SERVICE_ADDR")
+	mergeStrategy := os.Getenv("CART_MERGE_STRATEGY")
+	if mergeStrategy == "" {
+		mergeStrategy = domain.MergeSum
+	}
 
 	config = &Config{
 		Version:           version,
 		ServiceName:       serviceName,
 		Addr:              addr,
 		User_Service_Addr: user_service_addr,
+		MergeStrategy:     mergeStrategy,
 	}
 	validateMainConfig(config)
 }
@@ -43,5 +51,10 @@ func validateMainConfig(cfg *Config) {
 	if cfg.Version == "" || cfg.Addr == "" || cfg.ServiceName == "" || cfg.User_Service_Addr == "" {
 		log.Fatal().Msg("missing core service environment variables")
 	}
+	switch cfg.MergeStrategy {
+	case domain.MergeSum, domain.MergeMax, domain.MergeNewest:
+	default:
+		log.Fatal().Msgf("CART_MERGE_STRATEGY must be %s, %s or %s", domain.MergeSum, domain.MergeMax, domain.MergeNewest)
+	}
 
 }
@@ -9,14 +9,41 @@ type Cart struct {
 	Subtotal   float64    `json:"subtotal" redis:"subtotal"`
 	CreatedAt  time.Time  `json:"created_at" redis:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at" redis:"updated_at"`
+	Version    int64      `json:"version" redis:"version"` // Bumped on every write; 0 until first stored
 }
 
 type CartItem struct {
-	ProductID   string  `json:"product_id" redis:"product_id"`
-	Category    string  `json:"category" redis:"category"`
-	ProductName string  `json:"product_name" redis:"product_name"`
-	Price       float64 `json:"price" redis:"price"`
-	Quantity    int32   `json:"quantity" redis:"quantity"`
-	ImageURL    string  `json:"image_url" redis:"image_url"`
-	Subtotal    float64 `json:"subtotal" redis:"subtotal"`
+	ProductID   string    `json:"product_id" redis:"product_id"`
+	Category    string    `json:"category" redis:"category"`
+	ProductName string    `json:"product_name" redis:"product_name"`
+	Price       float64   `json:"price" redis:"price"`
+	Quantity    int32     `json:"quantity" redis:"quantity"`
+	ImageURL    string    `json:"image_url" redis:"image_url"`
+	Subtotal    float64   `json:"subtotal" redis:"subtotal"`
+	UpdatedAt   time.Time `json:"updated_at" redis:"updated_at"` // When added or its quantity last changed
 }
+
+// Warning codes for cart items that no longer match the product catalog.
+const (
+	WarningPriceChanged       = "price_changed"
+	WarningProductUnavailable = "product_unavailable"
+	WarningOutOfStock         = "out_of_stock"
+	WarningInsufficientStock  = "insufficient_stock"
+)
+
+type CartItemWarning struct {
+	ProductID    string
+	Code         string
+	Message      string
+	CartPrice    float64
+	CurrentPrice float64
+	Available    int32
+}
+
+// Merge strategies decide the quantity of an item that is in both the guest
+// cart and the user's cart.
+const (
+	MergeSum    = "sum"
+	MergeMax    = "max"
+	MergeNewest = "newest"
+)
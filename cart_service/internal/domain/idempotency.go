@@ -0,0 +1,14 @@
+package domain
+
+const OperationAddToCart = "AddToCart"
+
+// IdempotencyRecord tracks a request made with an idempotency key. Response
+// is empty while the first request is still being processed.
+type IdempotencyRecord struct {
+	RequestHash string `json:"request_hash"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+func (r *IdempotencyRecord) Completed() bool {
+	return len(r.Response) > 0
+}
@@ -40,6 +40,10 @@ func grpcCodeToHTTP(code codes.Code) int {
 		return 404
 	case codes.AlreadyExists:
 		return 409
+	case codes.FailedPrecondition:
+		return 409
+	case codes.Aborted:
+		return 409
 	case codes.Internal:
 		return 500
 	default:
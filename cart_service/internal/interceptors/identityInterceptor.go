@@ -0,0 +1,50 @@
+package interceptors
+
+import (
+	guestSessionRepo "cart_service/internal/repo/guestSession"
+	cartpb "cart_service/proto/gen"
+	"cart_service/utils"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxGuestSessionIDLength rejects ids that cannot have been issued before
+// they reach Redis.
+const maxGuestSessionIDLength = 100
+
+// IdentityInterceptor resolves whose cart a call works on and passes the
+// owner on to the handlers through the context: the signed-in user from the
+// x-user-email metadata set by the gateway, or else the guest session sent in
+// x-cart-session, which must have been issued by CreateGuestSession. Calls
+// with neither are rejected as Unauthenticated, except CreateGuestSession
+// itself.
+func IdentityInterceptor(sessions guestSessionRepo.Repo) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if info.FullMethod == cartpb.CartService_CreateGuestSession_FullMethodName {
+			return handler(ctx, req)
+		}
+
+		email, err := utils.GetUserEmailFromMetadata(ctx)
+		if err == nil {
+			return handler(utils.WithCartOwner(ctx, email), req)
+		}
+		sessionID := utils.GetGuestSessionFromMetadata(ctx)
+		if sessionID == "" {
+			return nil, err
+		}
+		if len(sessionID) > maxGuestSessionIDLength {
+			return nil, status.Error(codes.Unauthenticated, "invalid guest session")
+		}
+		exists, err := sessions.Touch(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, status.Error(codes.Unauthenticated, "guest session not found or expired")
+		}
+		return handler(utils.WithCartOwner(ctx, utils.GuestOwner(sessionID)), req)
+	}
+}
@@ -0,0 +1,157 @@
+package cartRepo
+
+import (
+	"cart_service/internal/domain"
+	"cart_service/utils"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A cart is stored as one hash per owner. Each item takes four fields keyed
+// by its product id, so changing one item never rewrites the others:
+//
+//	item:<product id>  the item details as JSON
+//	qty:<product id>   the quantity, changed with HINCRBY/HSET
+//	pos:<product id>   the order in which items were added
+//	at:<product id>    when the quantity last changed
+//
+// The meta: fields hold the cart version, its timestamps and the next item
+// position. Totals are derived when the cart is read.
+const (
+	itemFieldPrefix      = "item:"
+	quantityFieldPrefix  = "qty:"
+	positionFieldPrefix  = "pos:"
+	changedAtFieldPrefix = "at:"
+
+	versionField      = "meta:version"
+	createdAtField    = "meta:created_at"
+	updatedAtField    = "meta:updated_at"
+	nextPositionField = "meta:next_position"
+)
+
+// timeLayout is RFC 3339 with a fixed number of fractional digits, so times
+// in UTC sort as strings.
+const timeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// itemDetails is the item:<product id> value; quantity and subtotal are not
+// part of it since they change independently.
+type itemDetails struct {
+	ProductID   string  `json:"product_id"`
+	Category    string  `json:"category"`
+	ProductName string  `json:"product_name"`
+	Price       float64 `json:"price"`
+	ImageURL    string  `json:"image_url"`
+}
+
+func encodeItemDetails(item *domain.CartItem) (string, error) {
+	data, err := json.Marshal(itemDetails{
+		ProductID:   item.ProductID,
+		Category:    item.Category,
+		ProductName: item.ProductName,
+		Price:       item.Price,
+		ImageURL:    item.ImageURL,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// decodeCart builds the cart from the hash fields. An empty hash is a cart
+// that was never stored. Guest carts have no email.
+func decodeCart(owner string, fields map[string]string) (*domain.Cart, error) {
+	cart := &domain.Cart{
+		Items: []domain.CartItem{},
+	}
+	if !utils.IsGuestOwner(owner) {
+		cart.Email = owner
+	}
+	positions := map[string]int64{}
+	for field, value := range fields {
+		var err error
+		switch {
+		case field == versionField:
+			cart.Version, err = strconv.ParseInt(value, 10, 64)
+		case field == createdAtField:
+			cart.CreatedAt, err = time.Parse(time.RFC3339Nano, value)
+		case field == updatedAtField:
+			cart.UpdatedAt, err = time.Parse(time.RFC3339Nano, value)
+		case strings.HasPrefix(field, positionFieldPrefix):
+			positions[strings.TrimPrefix(field, positionFieldPrefix)], err = strconv.ParseInt(value, 10, 64)
+		case strings.HasPrefix(field, itemFieldPrefix):
+			var details itemDetails
+			if err = json.Unmarshal([]byte(value), &details); err != nil {
+				break
+			}
+			productID := strings.TrimPrefix(field, itemFieldPrefix)
+			quantity, convErr := strconv.ParseInt(fields[quantityFieldPrefix+productID], 10, 32)
+			if convErr != nil {
+				err = convErr
+				break
+			}
+			// Items stored before the at: field existed have no time.
+			var changedAt time.Time
+			if value, ok := fields[changedAtFieldPrefix+productID]; ok && value != "" {
+				if changedAt, err = time.Parse(time.RFC3339Nano, value); err != nil {
+					break
+				}
+			}
+			cart.Items = append(cart.Items, domain.CartItem{
+				ProductID:   productID,
+				Category:    details.Category,
+				ProductName: details.ProductName,
+				Price:       details.Price,
+				Quantity:    int32(quantity),
+				ImageURL:    details.ImageURL,
+				Subtotal:    details.Price * float64(quantity),
+				UpdatedAt:   changedAt,
+			})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid cart field %s: %w", field, err)
+		}
+	}
+
+	sort.Slice(cart.Items, func(i, j int) bool {
+		return positions[cart.Items[i].ProductID] < positions[cart.Items[j].ProductID]
+	})
+	utils.RecalculateSubTotal(cart)
+	return cart, nil
+}
+
+// legacyCartFields converts a cart stored as one JSON string, the layout
+// before hashes, into hash fields. The conversion counts as a write, so the
+// version moves on.
+func legacyCartFields(data string) (map[string]any, error) {
+	var cart domain.Cart
+	if err := json.Unmarshal([]byte(data), &cart); err != nil {
+		return nil, fmt.Errorf("invalid legacy cart: %w", err)
+	}
+
+	fields := map[string]any{
+		versionField:      cart.Version + 1,
+		createdAtField:    formatTime(cart.CreatedAt),
+		updatedAtField:    formatTime(cart.UpdatedAt),
+		nextPositionField: len(cart.Items),
+	}
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		details, err := encodeItemDetails(item)
+		if err != nil {
+			return nil, err
+		}
+		fields[itemFieldPrefix+item.ProductID] = details
+		fields[quantityFieldPrefix+item.ProductID] = item.Quantity
+		fields[positionFieldPrefix+item.ProductID] = i + 1
+		fields[changedAtFieldPrefix+item.ProductID] = formatTime(cart.UpdatedAt)
+	}
+	return fields, nil
+}
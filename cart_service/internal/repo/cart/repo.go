@@ -4,20 +4,73 @@ import (
 	"cart_service/internal/domain"
 	"cart_service/utils"
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	cartTTL = 7 * 24 * time.Hour
+	// maxMigrationAttempts bounds how often converting a legacy cart is
+	// retried when the cart changes while it is being converted.
+	maxMigrationAttempts = 5
+)
+
+var (
+	// ErrVersionMismatch is returned when a conditional change was made
+	// against a cart version that is no longer current.
+	ErrVersionMismatch = errors.New("cart was changed by another request")
+	ErrItemNotFound    = errors.New("product not found in cart")
+	// ErrLimitExceeded is returned by AddItem when the item's quantity would
+	// go over the given limit.
+	ErrLimitExceeded = errors.New("quantity limit exceeded")
+	// ErrInvalidQuantity is returned for a quantity the change cannot apply:
+	// adding fewer than one unit or setting a negative quantity.
+	ErrInvalidQuantity = errors.New("invalid quantity")
+	// ErrCartConflict is returned when a legacy cart kept changing while it
+	// was being converted, or a guest cart while it was being merged.
+	ErrCartConflict = errors.New("cart was modified concurrently, please retry")
+	// ErrSessionNotFound is returned for changes to a guest cart, or merges
+	// of one, whose session expired or was merged already.
+	ErrSessionNotFound = errors.New("guest session not found or expired")
+)
+
 type repo struct {
 	db *redis.Client
 }
 
+// Carts are kept per owner: the email of a signed-in user, or the value of
+// utils.GuestOwner for a guest session.
+//
+// Every change below is applied atomically and bumps the cart version. With
+// a non-zero expectedVersion the change only applies to that version of the
+// cart and fails with ErrVersionMismatch otherwise. Each returns the cart as
+// it is after the change.
 type Repo interface {
-	AddToCart(ctx context.Context, email string, payload *domain.Cart) (*domain.Cart, error)
-	GetCart(ctx context.Context, email string) (*domain.Cart, error)
-	DeleteCart(ctx context.Context, email string) error
+	GetCart(ctx context.Context, owner string) (*domain.Cart, error)
+	// AddItem adds item.Quantity units of the item, keeping the details of
+	// an item already in the cart, as long as the item's total quantity
+	// stays within limit.
+	AddItem(ctx context.Context, owner string, item domain.CartItem, limit int32, expectedVersion int64) (*domain.Cart, error)
+	// SetItemQuantity replaces the item's quantity; 0 removes the item.
+	SetItemQuantity(ctx context.Context, owner, productID string, quantity int32, expectedVersion int64) (*domain.Cart, error)
+	RemoveItem(ctx context.Context, owner, productID string, expectedVersion int64) (*domain.Cart, error)
+	// RefreshItems replaces the stored details, such as the price, of the
+	// given items and keeps their quantities and the cart's version. Items
+	// no longer in the cart are skipped.
+	RefreshItems(ctx context.Context, owner string, items []domain.CartItem) (*domain.Cart, error)
+	// MergeGuestCart moves the items of the guest session's cart into the
+	// owner's cart, resolving items in both with strategy, one of the
+	// domain.Merge constants. limits holds the most units of each guest item
+	// the cart may end up with; quantities the cart already held are kept
+	// even above it. The guest cart and session are deleted.
+	MergeGuestCart(ctx context.Context, owner, sessionID, strategy string, limits map[string]int32) (*domain.Cart, error)
+	// ClearCart removes every item. The emptied cart is kept so that its
+	// version is never reused.
+	ClearCart(ctx context.Context, owner string, expectedVersion int64) (*domain.Cart, error)
 }
 
 func NewRepo(db *redis.Client) Repo {
@@ -28,52 +81,159 @@ func NewRepo(db *redis.Client) Repo {
 
 }
 
-func (r *repo) AddToCart(ctx context.Context, email string, payload *domain.Cart) (*domain.Cart, error) {
-	key := utils.CreateKey(email)
-	data, error := json.Marshal(payload)
-	if error != nil {
-		return nil, error
-
+func (r *repo) GetCart(ctx context.Context, owner string) (*domain.Cart, error) {
+	key := utils.CreateKey(owner)
+	fields, err := r.db.HGetAll(ctx, key).Result()
+	if isWrongType(err) {
+		if err := r.migrateLegacyCart(ctx, key); err != nil {
+			return nil, err
+		}
+		fields, err = r.db.HGetAll(ctx, key).Result()
 	}
-	_, err := r.db.Set(ctx, key, data, 7*24*time.Hour).Result()
 	if err != nil {
 		return nil, err
+	}
+	return decodeCart(owner, fields)
+}
 
+func (r *repo) AddItem(ctx context.Context, owner string, item domain.CartItem, limit int32, expectedVersion int64) (*domain.Cart, error) {
+	details, err := encodeItemDetails(&item)
+	if err != nil {
+		return nil, err
 	}
+	return r.change(ctx, addItemScript, owner, nil, expectedVersion, item.ProductID, details, item.Quantity, limit)
+}
+
+func (r *repo) SetItemQuantity(ctx context.Context, owner, productID string, quantity int32, expectedVersion int64) (*domain.Cart, error) {
+	return r.change(ctx, setQuantityScript, owner, nil, expectedVersion, productID, quantity)
+}
 
-	return payload, nil
+func (r *repo) RemoveItem(ctx context.Context, owner, productID string, expectedVersion int64) (*domain.Cart, error) {
+	return r.change(ctx, removeItemScript, owner, nil, expectedVersion, productID)
 }
 
-func (r *repo) GetCart(ctx context.Context, email string) (*domain.Cart, error) {
-	key := utils.CreateKey(email)
-	val, err := r.db.Get(ctx, key).Result()
-	if err == redis.Nil {
-		return &domain.Cart{
-			Email: email,
-			Items: []domain.CartItem{},
-		}, nil
+func (r *repo) RefreshItems(ctx context.Context, owner string, items []domain.CartItem) (*domain.Cart, error) {
+	args := make([]any, 0, 2*len(items))
+	for i := range items {
+		details, err := encodeItemDetails(&items[i])
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, items[i].ProductID, details)
 	}
-	if err != nil {
-		return nil, err
+	return r.change(ctx, refreshItemsScript, owner, nil, 0, args...)
+}
 
+func (r *repo) MergeGuestCart(ctx context.Context, owner, sessionID, strategy string, limits map[string]int32) (*domain.Cart, error) {
+	guestKeys := []string{
+		utils.CreateGuestSessionKey(sessionID),
+		utils.CreateKey(utils.GuestOwner(sessionID)),
 	}
+	args := make([]any, 0, 1+2*len(limits))
+	args = append(args, strategy)
+	for productID, limit := range limits {
+		args = append(args, productID, limit)
+	}
+	return r.change(ctx, mergeScript, owner, guestKeys, 0, args...)
+}
+
+func (r *repo) ClearCart(ctx context.Context, owner string, expectedVersion int64) (*domain.Cart, error) {
+	return r.change(ctx, clearScript, owner, nil, expectedVersion)
+}
 
-	cart := &domain.Cart{}
-	if err := json.Unmarshal([]byte(val), cart); err != nil {
+// change runs one of the cart scripts on the owner's cart, converting a
+// legacy cart first if the script finds one. extraKeys follow the cart key
+// and, for a guest owner, the key of its session.
+func (r *repo) change(ctx context.Context, script *redis.Script, owner string, extraKeys []string, expectedVersion int64, extra ...any) (*domain.Cart, error) {
+	key := utils.CreateKey(owner)
+	keys := []string{key}
+	if utils.IsGuestOwner(owner) {
+		keys = append(keys, utils.CreateGuestSessionKey(utils.GuestSessionID(owner)))
+	}
+	keys = append(keys, extraKeys...)
+	args := append([]any{
+		expectedVersion,
+		formatTime(time.Now()),
+		int64(cartTTL / time.Second),
+	}, extra...)
+
+	reply, err := script.Run(ctx, r.db, keys, args...).Slice()
+	if isWrongType(err) {
+		if err := r.migrateLegacyCart(ctx, key); err != nil {
+			return nil, err
+		}
+		reply, err = script.Run(ctx, r.db, keys, args...).Slice()
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	return cart, nil
-
+	switch reply[0] {
+	case replyOK:
+	case replyVersionMismatch:
+		return nil, ErrVersionMismatch
+	case replyItemNotFound:
+		return nil, ErrItemNotFound
+	case replyLimitExceeded:
+		return nil, ErrLimitExceeded
+	case replyInvalidQuantity:
+		return nil, ErrInvalidQuantity
+	case replySessionNotFound:
+		return nil, ErrSessionNotFound
+	case replyGuestCartChanged:
+		return nil, ErrCartConflict
+	default:
+		return nil, fmt.Errorf("unexpected cart script reply %v", reply[0])
+	}
+	pairs, ok := reply[1].([]any)
+	if !ok || len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("unexpected cart script reply %v", reply[1])
+	}
+	fields := make(map[string]string, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		field, _ := pairs[i].(string)
+		value, _ := pairs[i+1].(string)
+		fields[field] = value
+	}
+	return decodeCart(owner, fields)
 }
 
-func (r *repo) DeleteCart(ctx context.Context, email string) error {
-
-	key := utils.CreateKey(email)
-	_, err := r.db.Del(ctx, key).Result()
-	if err != nil {
-		return err
+// migrateLegacyCart replaces a cart stored as a JSON string with the hash
+// layout. It does nothing if the key no longer holds a string, e.g. because
+// another request converted it first.
+func (r *repo) migrateLegacyCart(ctx context.Context, key string) error {
+	for attempt := 0; attempt < maxMigrationAttempts; attempt++ {
+		err := r.db.Watch(ctx, func(tx *redis.Tx) error {
+			keyType, err := tx.Type(ctx, key).Result()
+			if err != nil || keyType != "string" {
+				return err
+			}
+			data, err := tx.Get(ctx, key).Result()
+			if err != nil {
+				return err
+			}
+			fields, err := legacyCartFields(data)
+			if err != nil {
+				return err
+			}
+			// EXEC fails with TxFailedErr if the key was written since WATCH.
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Del(ctx, key)
+				pipe.HSet(ctx, key, fields)
+				pipe.Expire(ctx, key, cartTTL)
+				return nil
+			})
+			return err
+		}, key)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
 	}
-	return nil
+	return ErrCartConflict
+}
 
+// isWrongType reports whether err comes from a hash command, possibly run by
+// a script, hitting a legacy JSON cart.
+func isWrongType(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "WRONGTYPE")
 }
@@ -0,0 +1,198 @@
+package cartRepo
+
+import "github.com/redis/go-redis/v9"
+
+// The scripts below change a cart hash atomically. They share the calling
+// convention
+//
+//	KEYS[1]  the cart hash
+//	KEYS[2]  optional guest session that must still exist
+//	ARGV[1]  expected version, 0 for an unconditional change
+//	ARGV[2]  current time, RFC 3339
+//	ARGV[3]  cart TTL in seconds
+//
+// followed by script specific arguments. Times are in the fixed width layout
+// of formatTime, so Lua can compare them as strings. The scripts reply {"OK", <HGETALL of the
+// cart>} on success or {<reason>} when the change was rejected.
+
+const (
+	replyOK               = "OK"
+	replyVersionMismatch  = "VERSION_MISMATCH"
+	replyItemNotFound     = "ITEM_NOT_FOUND"
+	replyLimitExceeded    = "LIMIT_EXCEEDED"
+	replyInvalidQuantity  = "INVALID_QUANTITY"
+	replySessionNotFound  = "SESSION_NOT_FOUND"
+	replyGuestCartChanged = "GUEST_CART_CHANGED"
+)
+
+// scriptPrologue checks the session in the script, so a guest cart cannot be
+// written again once a merge deleted its session.
+const scriptPrologue = `
+local key = KEYS[1]
+if KEYS[2] and redis.call('EXISTS', KEYS[2]) == 0 then
+	return {'SESSION_NOT_FOUND'}
+end
+local expected = tonumber(ARGV[1])
+if expected ~= 0 and expected ~= tonumber(redis.call('HGET', key, 'meta:version') or '0') then
+	return {'VERSION_MISMATCH'}
+end
+`
+
+// itemPrologue names the fields of the item whose product id is ARGV[4].
+const itemPrologue = scriptPrologue + `
+local productID = ARGV[4]
+local itemField = 'item:' .. productID
+local qtyField = 'qty:' .. productID
+local posField = 'pos:' .. productID
+local atField = 'at:' .. productID
+`
+
+const scriptEpilogue = `
+redis.call('HINCRBY', key, 'meta:version', 1)
+redis.call('HSETNX', key, 'meta:created_at', ARGV[2])
+redis.call('HSET', key, 'meta:updated_at', ARGV[2])
+redis.call('EXPIRE', key, ARGV[3])
+return {'OK', redis.call('HGETALL', key)}
+`
+
+// addItemScript adds ARGV[6] units of the item, whose details are the JSON in
+// ARGV[5]. Details of an item already in the cart are kept, and the total
+// quantity may not exceed ARGV[7].
+var addItemScript = redis.NewScript(itemPrologue + `
+if tonumber(ARGV[6]) <= 0 then
+	return {'INVALID_QUANTITY'}
+end
+local current = tonumber(redis.call('HGET', key, qtyField) or '0')
+if current + tonumber(ARGV[6]) > tonumber(ARGV[7]) then
+	return {'LIMIT_EXCEEDED'}
+end
+if redis.call('HEXISTS', key, itemField) == 0 then
+	local position = redis.call('HINCRBY', key, 'meta:next_position', 1)
+	redis.call('HSET', key, itemField, ARGV[5], posField, position)
+end
+redis.call('HINCRBY', key, qtyField, ARGV[6])
+redis.call('HSET', key, atField, ARGV[2])
+` + scriptEpilogue)
+
+// setQuantityScript sets the item's quantity to ARGV[5], removing the item
+// when it is 0.
+var setQuantityScript = redis.NewScript(itemPrologue + `
+if tonumber(ARGV[5]) < 0 then
+	return {'INVALID_QUANTITY'}
+end
+if redis.call('HEXISTS', key, itemField) == 0 then
+	return {'ITEM_NOT_FOUND'}
+end
+if tonumber(ARGV[5]) == 0 then
+	redis.call('HDEL', key, itemField, qtyField, posField, atField)
+else
+	redis.call('HSET', key, qtyField, ARGV[5], atField, ARGV[2])
+end
+` + scriptEpilogue)
+
+var removeItemScript = redis.NewScript(itemPrologue + `
+if redis.call('HDEL', key, itemField, qtyField, posField, atField) == 0 then
+	return {'ITEM_NOT_FOUND'}
+end
+` + scriptEpilogue)
+
+// refreshItemsScript replaces item details given as pairs of product id and
+// details JSON from ARGV[4] on. Items no longer in the cart are skipped. It
+// only brings the cart up to date with the catalog, so unlike the other
+// scripts it keeps the version and updated_at.
+var refreshItemsScript = redis.NewScript(scriptPrologue + `
+for i = 4, #ARGV, 2 do
+	local itemField = 'item:' .. ARGV[i]
+	if redis.call('HEXISTS', key, itemField) == 1 then
+		redis.call('HSET', key, itemField, ARGV[i + 1])
+	end
+end
+redis.call('EXPIRE', key, ARGV[3])
+return {'OK', redis.call('HGETALL', key)}
+`)
+
+// mergeScript moves the items of the guest cart KEYS[3] into the cart, in the
+// order they were added to the guest cart, and deletes the guest cart and its
+// session KEYS[2]. ARGV[4] is the strategy for items in both carts: sum adds
+// the quantities, max keeps the larger quantity and newest the item changed
+// last, each together with its details. From ARGV[5] on come pairs of product
+// id and the most units of it the cart may hold. Merged quantities are capped
+// at that limit, but never below what the cart held already, and new items
+// without stock are left out. A guest item without a limit was added after
+// the limits were looked up, so the merge is rejected.
+var mergeScript = redis.NewScript(scriptPrologue + `
+local strategy = ARGV[4]
+local limits = {}
+for i = 5, #ARGV, 2 do
+	limits[ARGV[i]] = tonumber(ARGV[i + 1])
+end
+local function capped(quantity, current, limit)
+	if quantity <= current then
+		return quantity
+	end
+	return math.max(current, math.min(quantity, limit))
+end
+
+local guest = redis.call('HGETALL', KEYS[3])
+local fields = {}
+for i = 1, #guest, 2 do
+	fields[guest[i]] = guest[i + 1]
+end
+local items = {}
+for field, details in pairs(fields) do
+	if string.sub(field, 1, 5) == 'item:' then
+		local productID = string.sub(field, 6)
+		table.insert(items, {
+			productID = productID,
+			details = details,
+			quantity = tonumber(fields['qty:' .. productID] or '0'),
+			changedAt = fields['at:' .. productID] or '',
+			position = tonumber(fields['pos:' .. productID] or '0'),
+		})
+	end
+end
+table.sort(items, function(a, b) return a.position < b.position end)
+for _, item in ipairs(items) do
+	if limits[item.productID] == nil then
+		return {'GUEST_CART_CHANGED'}
+	end
+end
+
+for _, item in ipairs(items) do
+	local itemField = 'item:' .. item.productID
+	local qtyField = 'qty:' .. item.productID
+	local atField = 'at:' .. item.productID
+	local limit = limits[item.productID]
+	if redis.call('HEXISTS', key, itemField) == 0 then
+		local quantity = capped(item.quantity, 0, limit)
+		if quantity > 0 then
+			local position = redis.call('HINCRBY', key, 'meta:next_position', 1)
+			redis.call('HSET', key, itemField, item.details, qtyField, quantity,
+				'pos:' .. item.productID, position, atField, item.changedAt)
+		end
+	else
+		local current = tonumber(redis.call('HGET', key, qtyField) or '0')
+		local currentAt = redis.call('HGET', key, atField) or ''
+		if strategy == 'sum' then
+			redis.call('HSET', key, qtyField, capped(current + item.quantity, current, limit), atField, ARGV[2])
+		elseif (strategy == 'max' and item.quantity > current) or (strategy == 'newest' and item.changedAt > currentAt) then
+			redis.call('HSET', key, itemField, item.details, qtyField, capped(item.quantity, current, limit), atField, item.changedAt)
+		end
+	end
+end
+redis.call('DEL', KEYS[2], KEYS[3])
+` + scriptEpilogue)
+
+// clearScript removes every item but keeps the meta: fields, so the version
+// of an emptied cart keeps increasing. A cart that was never stored is left
+// alone.
+var clearScript = redis.NewScript(scriptPrologue + `
+if redis.call('EXISTS', key) == 0 then
+	return {'OK', {}}
+end
+for _, field in ipairs(redis.call('HKEYS', key)) do
+	if string.sub(field, 1, 5) ~= 'meta:' then
+		redis.call('HDEL', key, field)
+	end
+end
+` + scriptEpilogue)
@@ -0,0 +1,38 @@
+package guestSessionRepo
+
+import (
+	"cart_service/utils"
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SessionTTL matches the cart lifetime; both are extended while the guest
+// keeps using the cart.
+const SessionTTL = 7 * 24 * time.Hour
+
+type repo struct {
+	db *redis.Client
+}
+
+type Repo interface {
+	Create(ctx context.Context, sessionID string) error
+	// Touch extends an issued session and reports whether it still exists.
+	Touch(ctx context.Context, sessionID string) (bool, error)
+}
+
+func NewRepo(db *redis.Client) Repo {
+
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Create(ctx context.Context, sessionID string) error {
+	return r.db.Set(ctx, utils.CreateGuestSessionKey(sessionID), time.Now().UTC().Format(time.RFC3339), SessionTTL).Err()
+}
+
+func (r *repo) Touch(ctx context.Context, sessionID string) (bool, error) {
+	return r.db.Expire(ctx, utils.CreateGuestSessionKey(sessionID), SessionTTL).Result()
+}
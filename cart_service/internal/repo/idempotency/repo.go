@@ -0,0 +1,81 @@
+package idempotencyRepo
+
+import (
+	"cart_service/internal/domain"
+	"cart_service/utils"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// claimTTL bounds how long an unfinished request blocks its key, so a
+	// crash mid-request does not lock the key for the whole replay window.
+	claimTTL = 30 * time.Second
+	// ResponseTTL is how long a stored response is replayed.
+	ResponseTTL = 24 * time.Hour
+)
+
+type repo struct {
+	db *redis.Client
+}
+
+type Repo interface {
+	// Claim reserves the key for a new request. It returns nil if the key was
+	// free, otherwise the record already stored under it.
+	Claim(ctx context.Context, email, operation, key, requestHash string) (*domain.IdempotencyRecord, error)
+	// Complete stores the response for a claimed key.
+	Complete(ctx context.Context, email, operation, key string, record *domain.IdempotencyRecord) error
+	// Release frees a claimed key after the request failed.
+	Release(ctx context.Context, email, operation, key string) error
+}
+
+func NewRepo(db *redis.Client) Repo {
+
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Claim(ctx context.Context, email, operation, key, requestHash string) (*domain.IdempotencyRecord, error) {
+	redisKey := utils.CreateIdempotencyKey(email, operation, key)
+	data, err := json.Marshal(&domain.IdempotencyRecord{RequestHash: requestHash})
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := r.db.SetNX(ctx, redisKey, data, claimTTL).Result()
+	if err != nil {
+		return nil, err
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	val, err := r.db.Get(ctx, redisKey).Bytes()
+	if err == redis.Nil {
+		// The other claim expired in between, so the key is free again.
+		return r.Claim(ctx, email, operation, key, requestHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	record := &domain.IdempotencyRecord{}
+	if err := json.Unmarshal(val, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (r *repo) Complete(ctx context.Context, email, operation, key string, record *domain.IdempotencyRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(ctx, utils.CreateIdempotencyKey(email, operation, key), data, ResponseTTL).Err()
+}
+
+func (r *repo) Release(ctx context.Context, email, operation, key string) error {
+	return r.db.Del(ctx, utils.CreateIdempotencyKey(email, operation, key)).Err()
+}
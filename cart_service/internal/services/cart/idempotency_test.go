@@ -0,0 +1,43 @@
+package cartService
+
+import (
+	"cart_service/internal/domain"
+	cartpb "cart_service/proto/gen"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReplayAddToCart(t *testing.T) {
+	stored, err := proto.Marshal(&cartpb.CartResponse{Email: "a@example.com", Version: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := replayAddToCart(&domain.IdempotencyRecord{RequestHash: "hash-1", Response: stored}, "hash-1")
+	if err != nil {
+		t.Fatalf("replay of the same request: %v", err)
+	}
+	if resp.Version != 3 {
+		t.Errorf("replayed version %d, want 3", resp.Version)
+	}
+
+	tests := []struct {
+		name   string
+		record *domain.IdempotencyRecord
+		code   codes.Code
+	}{
+		{"different request", &domain.IdempotencyRecord{RequestHash: "hash-2", Response: stored}, codes.AlreadyExists},
+		{"first request still running", &domain.IdempotencyRecord{RequestHash: "hash-1"}, codes.Aborted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := replayAddToCart(tt.record, "hash-1")
+			if status.Code(err) != tt.code {
+				t.Errorf("got %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package cartService
+
+import (
+	"cart_service/internal/domain"
+	cartpb "cart_service/proto/gen"
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMergeLimits(t *testing.T) {
+	guestCart := &domain.Cart{Items: []domain.CartItem{
+		{ProductID: "p1", Category: "books", Quantity: 3},
+		{ProductID: "p2", Category: "games", Quantity: 1},
+	}}
+	ctx := context.Background()
+
+	t.Run("stock per item", func(t *testing.T) {
+		s := &service{productClient: &stubCatalog{products: []*cartpb.Product{
+			{ProductId: "p1", Available: 2},
+			{ProductId: "p3", Available: 9},
+		}}}
+		limits, err := s.mergeLimits(ctx, guestCart)
+		if err != nil {
+			t.Fatalf("mergeLimits: %v", err)
+		}
+		// p2 is gone from the catalog and p3 is not in the guest cart.
+		if len(limits) != 2 || limits["p1"] != 2 || limits["p2"] != 0 {
+			t.Errorf("limits = %v, want p1:2 p2:0", limits)
+		}
+	})
+
+	t.Run("catalog unreachable", func(t *testing.T) {
+		s := &service{productClient: &stubCatalog{err: errors.New("unavailable")}}
+		if _, err := s.mergeLimits(ctx, guestCart); status.Code(err) != codes.Unavailable {
+			t.Errorf("code = %v, want Unavailable (%v)", status.Code(err), err)
+		}
+	})
+
+	t.Run("empty guest cart", func(t *testing.T) {
+		s := &service{productClient: &stubCatalog{err: errors.New("unavailable")}}
+		limits, err := s.mergeLimits(ctx, &domain.Cart{})
+		if err != nil || len(limits) != 0 {
+			t.Errorf("limits = %v (%v), want none", limits, err)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package cartService
+
+import (
+	"cart_service/internal/domain"
+	cartpb "cart_service/proto/gen"
+	"cart_service/utils"
+	"context"
+	"fmt"
+	"log"
+)
+
+// revalidate compares the cart with the current catalog and returns a warning
+// per item whose price changed or that can no longer be bought as is. With
+// applyUpdates, changed item details are written back and the updated cart is
+// returned. The last result reports whether the catalog could be reached; if
+// not, the cart is returned unchecked.
+func (s *service) revalidate(ctx context.Context, owner string, cart *domain.Cart, applyUpdates bool) (*domain.Cart, []domain.CartItemWarning, bool) {
+	if len(cart.Items) == 0 {
+		return cart, nil, true
+	}
+
+	keys := make([]*cartpb.ProductKey, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		keys = append(keys, &cartpb.ProductKey{Category: item.Category, ProductId: item.ProductID})
+	}
+	products, err := s.productClient.BatchGetProducts(ctx, keys)
+	if err != nil {
+		log.Printf("failed to revalidate cart: %v", err)
+		return cart, nil, false
+	}
+	current := make(map[string]*cartpb.Product, len(products))
+	for _, product := range products {
+		current[product.ProductId] = product
+	}
+
+	var warnings []domain.CartItemWarning
+	var refreshed []domain.CartItem
+	for _, item := range cart.Items {
+		product, ok := current[item.ProductID]
+		if !ok {
+			warnings = append(warnings, domain.CartItemWarning{
+				ProductID: item.ProductID,
+				Code:      domain.WarningProductUnavailable,
+				Message:   fmt.Sprintf("%s is no longer available", item.ProductName),
+				CartPrice: item.Price,
+			})
+			continue
+		}
+		warnings = append(warnings, itemWarnings(item, product)...)
+		latest := utils.CreateCartItem(product, item.Quantity)
+		if latest.Price != item.Price || latest.ProductName != item.ProductName || latest.ImageURL != item.ImageURL {
+			refreshed = append(refreshed, latest)
+		}
+	}
+
+	if applyUpdates && len(refreshed) > 0 {
+		updated, err := s.repo.RefreshItems(ctx, owner, refreshed)
+		if err != nil {
+			log.Printf("failed to update cart items: %v", err)
+			return cart, warnings, true
+		}
+		cart = updated
+	}
+	return cart, warnings, true
+}
+
+// itemWarnings flags a price change and stock that falls short of the
+// quantity in the cart.
+func itemWarnings(item domain.CartItem, product *cartpb.Product) []domain.CartItemWarning {
+	var warnings []domain.CartItemWarning
+	warn := func(code, message string) {
+		warnings = append(warnings, domain.CartItemWarning{
+			ProductID:    item.ProductID,
+			Code:         code,
+			Message:      message,
+			CartPrice:    item.Price,
+			CurrentPrice: product.Price,
+			Available:    product.Available,
+		})
+	}
+
+	if product.Price != item.Price {
+		warn(domain.WarningPriceChanged,
+			fmt.Sprintf("price of %s changed from %.2f to %.2f", product.Name, item.Price, product.Price))
+	}
+	switch {
+	case product.Available <= 0:
+		warn(domain.WarningOutOfStock, fmt.Sprintf("%s is out of stock", product.Name))
+	case product.Available < item.Quantity:
+		warn(domain.WarningInsufficientStock,
+			fmt.Sprintf("only %d units of %s are available, %d in cart", product.Available, product.Name, item.Quantity))
+	}
+	return warnings
+}
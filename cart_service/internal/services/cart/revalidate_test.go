@@ -0,0 +1,66 @@
+package cartService
+
+import (
+	client "cart_service/internal/clients/product"
+	"cart_service/internal/domain"
+	cartpb "cart_service/proto/gen"
+	"context"
+	"errors"
+	"testing"
+)
+
+type stubCatalog struct {
+	client.Client
+	products []*cartpb.Product
+	err      error
+}
+
+func (c *stubCatalog) BatchGetProducts(ctx context.Context, keys []*cartpb.ProductKey) ([]*cartpb.Product, error) {
+	return c.products, c.err
+}
+
+func TestRevalidate(t *testing.T) {
+	cart := &domain.Cart{Items: []domain.CartItem{
+		{ProductID: "p1", Category: "books", ProductName: "Book", Price: 10, Quantity: 3},
+		{ProductID: "p2", Category: "games", ProductName: "Game", Price: 20, Quantity: 1},
+	}}
+	ctx := context.Background()
+
+	t.Run("catalog unreachable", func(t *testing.T) {
+		s := &service{productClient: &stubCatalog{err: errors.New("unavailable")}}
+		_, warnings, checked := s.revalidate(ctx, "a@example.com", cart, false)
+		if checked || len(warnings) != 0 {
+			t.Errorf("checked %v with %d warnings, want an unchecked cart", checked, len(warnings))
+		}
+	})
+
+	t.Run("empty cart", func(t *testing.T) {
+		s := &service{productClient: &stubCatalog{err: errors.New("unavailable")}}
+		if _, _, checked := s.revalidate(ctx, "a@example.com", &domain.Cart{}, false); !checked {
+			t.Error("an empty cart needs no catalog and counts as checked")
+		}
+	})
+
+	t.Run("catalog changed", func(t *testing.T) {
+		s := &service{productClient: &stubCatalog{products: []*cartpb.Product{
+			{ProductId: "p1", Category: "books", Name: "Book", Price: 12, Available: 2},
+		}}}
+		_, warnings, checked := s.revalidate(ctx, "a@example.com", cart, false)
+		if !checked {
+			t.Fatal("cart not checked")
+		}
+		codes := map[string]string{}
+		for _, w := range warnings {
+			codes[w.ProductID+" "+w.Code] = w.Message
+		}
+		for _, want := range []string{
+			"p1 " + domain.WarningPriceChanged,
+			"p1 " + domain.WarningInsufficientStock,
+			"p2 " + domain.WarningProductUnavailable,
+		} {
+			if _, ok := codes[want]; !ok {
+				t.Errorf("missing warning %s in %v", want, codes)
+			}
+		}
+	})
+}
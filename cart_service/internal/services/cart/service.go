@@ -4,6 +4,8 @@ import (
 	client "cart_service/internal/clients/product"
 	"cart_service/internal/domain"
 	cartRepo "cart_service/internal/repo/cart"
+	guestSessionRepo "cart_service/internal/repo/guestSession"
+	idempotencyRepo "cart_service/internal/repo/idempotency"
 	cartpb "cart_service/proto/gen"
 	"cart_service/utils"
 	"context"
@@ -11,150 +13,269 @@ import (
 	"log"
 	"time"
 
-	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type service struct {
 	repo          cartRepo.Repo
+	keys          idempotencyRepo.Repo
+	sessions      guestSessionRepo.Repo
 	productClient client.Client
+	mergeStrategy string
 }
 
+// The owner of a cart is the caller's email, or utils.GuestOwner of the guest
+// session for visitors who are not signed in.
 type Service interface {
-	AddToCart(ctx context.Context, email string, req *cartpb.AddToCartRequest) (*cartpb.CartResponse, error)
-	GetCart(ctx context.Context, email string) (*cartpb.CartResponse, error)
-	UpdateCart(ctx context.Context, email string, req *cartpb.UpdateCartItemRequest) (*cartpb.CartResponse, error)
-	Delete(ctx context.Context, email string, req *cartpb.RemoveFromCartRequest) (string, error)
+	AddToCart(ctx context.Context, owner, idempotencyKey string, req *cartpb.AddToCartRequest) (*cartpb.CartResponse, error)
+	// GetCart returns the cart with warnings for items that no longer match
+	// the catalog; with applyUpdates their stored details are updated too.
+	GetCart(ctx context.Context, owner string, applyUpdates bool) (*cartpb.CartResponse, error)
+	UpdateCart(ctx context.Context, owner string, req *cartpb.UpdateCartItemRequest) (*cartpb.CartResponse, error)
+	Delete(ctx context.Context, owner string, req *cartpb.RemoveFromCartRequest) (*cartpb.CartResponse, error)
+	// ClearCart removes every item from the cart; clearing an empty cart
+	// succeeds.
+	ClearCart(ctx context.Context, owner string, req *cartpb.ClearCartRequest) (*cartpb.CartResponse, error)
+	CreateGuestSession(ctx context.Context) (*cartpb.GuestSession, error)
+	// MergeCart moves the guest cart into the signed-in owner's cart. Items
+	// in both are combined with the requested strategy, or the default one.
+	MergeCart(ctx context.Context, owner string, req *cartpb.MergeCartRequest) (*cartpb.CartResponse, error)
 }
 
-func NewService(repo cartRepo.Repo, productClient client.Client) Service {
+func NewService(repo cartRepo.Repo, keys idempotencyRepo.Repo, sessions guestSessionRepo.Repo, productClient client.Client, mergeStrategy string) Service {
 
 	return &service{
 		repo:          repo,
+		keys:          keys,
+		sessions:      sessions,
 		productClient: productClient,
+		mergeStrategy: mergeStrategy,
 	}
 }
 
-func (s *service) AddToCart(ctx context.Context, email string, req *cartpb.AddToCartRequest) (*cartpb.CartResponse, error) {
+// AddToCart adds the requested quantity to the cart. With an idempotency key
+// the first successful response is replayed for repeats of the same request,
+// so retries do not add the quantity twice.
+func (s *service) AddToCart(ctx context.Context, owner, idempotencyKey string, req *cartpb.AddToCartRequest) (*cartpb.CartResponse, error) {
 
-	if email == "" {
+	if owner == "" {
 		return nil, errors.New("Unauthorized")
 
 	}
-	existingCart, err := s.repo.GetCart(ctx, email)
+	if idempotencyKey == "" {
+		return s.addToCart(ctx, owner, req)
+	}
 
-	if err != nil && err != redis.Nil {
+	hash, err := utils.RequestHash(req)
+	if err != nil {
 		return nil, err
 	}
-	if existingCart == nil {
-		existingCart = &domain.Cart{
-			Email:     email,
-			Items:     []domain.CartItem{},
-			CreatedAt: time.Now(),
-		}
+	record, err := s.keys.Claim(ctx, owner, domain.OperationAddToCart, idempotencyKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	if record != nil {
+		return replayAddToCart(record, hash)
 	}
 
-	itemIndex := utils.FindCartIndex(existingCart.Items, req.ProductId)
-	if itemIndex >= 0 {
-		utils.UpdateItemQuantity(&existingCart.Items[itemIndex], req.Quantity)
-	} else {
-		product, err := s.productClient.GetProductById(ctx, &cartpb.GetProductByIdRequest{
-			Category:  req.Category,
-			ProductId: req.ProductId,
-		})
-		if err != nil {
-			return nil, err
+	resp, err := s.addToCart(ctx, owner, req)
+	if err != nil {
+		if releaseErr := s.keys.Release(ctx, owner, domain.OperationAddToCart, idempotencyKey); releaseErr != nil {
+			log.Printf("failed to release idempotency key %s: %v", idempotencyKey, releaseErr)
 		}
+		return nil, err
+	}
 
-		newItem := utils.CreateCartItem(product, req.Quantity)
-		existingCart.Items = append(existingCart.Items, newItem)
-
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, err
 	}
+	record = &domain.IdempotencyRecord{RequestHash: hash, Response: data}
+	if err := s.keys.Complete(ctx, owner, domain.OperationAddToCart, idempotencyKey, record); err != nil {
+		// The cart is already updated; a retry after the claim expires would
+		// add the quantity again, but failing here would too.
+		log.Printf("failed to store response for idempotency key %s: %v", idempotencyKey, err)
+	}
+	return resp, nil
+}
 
-	utils.RecalculateSubTotal(existingCart)
-	existingCart.UpdatedAt = time.Now().UTC()
-	savedCart, err := s.repo.AddToCart(ctx, email, existingCart)
+func replayAddToCart(record *domain.IdempotencyRecord, hash string) (*cartpb.CartResponse, error) {
+	if record.RequestHash != hash {
+		return nil, status.Error(codes.AlreadyExists, "idempotency-key was already used for a different request")
+	}
+	if !record.Completed() {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency-key is still in progress")
+	}
+	resp := &cartpb.CartResponse{}
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
 
+func (s *service) addToCart(ctx context.Context, owner string, req *cartpb.AddToCartRequest) (*cartpb.CartResponse, error) {
+	product, err := s.productClient.GetProductById(ctx, &cartpb.GetProductByIdRequest{
+		Category:  req.Category,
+		ProductId: req.ProductId,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	savedCart, err := s.repo.AddItem(ctx, owner, utils.CreateCartItem(product, req.Quantity), product.Available, req.ExpectedVersion)
+	if errors.Is(err, cartRepo.ErrLimitExceeded) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"only %d units of %s are available", product.Available, product.Name)
+	}
+	if err != nil {
+		return nil, mapRepoError(err)
+	}
+
 	return utils.DomainCartToProto(savedCart), nil
 }
 
-func (s *service) GetCart(ctx context.Context, email string) (*cartpb.CartResponse, error) {
+func (s *service) GetCart(ctx context.Context, owner string, applyUpdates bool) (*cartpb.CartResponse, error) {
 
-	if email == "" {
+	if owner == "" {
 		return nil, errors.New("Unauthorized")
 
 	}
-	resp, err := s.repo.GetCart(ctx, email)
+	cart, err := s.repo.GetCart(ctx, owner)
 	if err != nil {
-		return nil, err
+		return nil, mapRepoError(err)
 
 	}
-	return utils.DomainCartToProto(resp), nil
+	cart, warnings, checked := s.revalidate(ctx, owner, cart, applyUpdates)
+	resp := utils.DomainCartToProto(cart)
+	resp.Warnings = utils.CartWarningsToProto(warnings)
+	resp.Revalidated = checked
+	return resp, nil
 
 }
 
-func (s *service) UpdateCart(ctx context.Context, email string, req *cartpb.UpdateCartItemRequest) (*cartpb.CartResponse, error) {
+func (s *service) UpdateCart(ctx context.Context, owner string, req *cartpb.UpdateCartItemRequest) (*cartpb.CartResponse, error) {
 
-	if email == "" {
+	if owner == "" {
 		return nil, errors.New("Unauthorized")
 	}
-	cart, err := s.repo.GetCart(ctx, email)
+	savedCart, err := s.repo.SetItemQuantity(ctx, owner, req.ProductId, req.Quantity, req.ExpectedVersion)
 	if err != nil {
-		return nil, err
-	}
-	itemIndex := utils.FindCartIndex(cart.Items, req.ProductId)
-	if itemIndex < 0 {
-		return nil, errors.New("product not found in cart")
+		return nil, mapRepoError(err)
 	}
-	if req.Quantity == 0 {
-		cart.Items = append(cart.Items[:itemIndex], cart.Items[itemIndex+1:]...)
+	return utils.DomainCartToProto(savedCart), nil
+}
+
+// Delete removes the item from the cart. An emptied cart is kept rather than
+// deleted so its version keeps increasing.
+func (s *service) Delete(ctx context.Context, owner string, req *cartpb.RemoveFromCartRequest) (*cartpb.CartResponse, error) {
+	if owner == "" {
+		return nil, errors.New("Unauthorized")
 
-	} else {
-		cart.Items[itemIndex].Quantity = req.Quantity
-		cart.Items[itemIndex].Subtotal = cart.Items[itemIndex].Price * float64(req.Quantity)
 	}
-	utils.RecalculateSubTotal(cart)
-	cart.UpdatedAt = time.Now().UTC()
-	savedCart, err := s.repo.AddToCart(ctx, email, cart)
+	savedCart, err := s.repo.RemoveItem(ctx, owner, req.ProductId, req.ExpectedVersion)
 	if err != nil {
-		return nil, err
+		return nil, mapRepoError(err)
 	}
 	return utils.DomainCartToProto(savedCart), nil
 }
 
-func (s *service) Delete(ctx context.Context, email string, req *cartpb.RemoveFromCartRequest) (string, error) {
-	if email == "" {
-		return "", errors.New("Unauthorized")
-
+// ClearCart empties the cart instead of deleting it, for the same reason as
+// Delete: a new cart would start over at version 1 and accept changes made
+// against the old one.
+func (s *service) ClearCart(ctx context.Context, owner string, req *cartpb.ClearCartRequest) (*cartpb.CartResponse, error) {
+	if owner == "" {
+		return nil, status.Error(codes.Unauthenticated, "cart owner not found in metadata")
 	}
-	cart, err := s.repo.GetCart(ctx, email)
+	savedCart, err := s.repo.ClearCart(ctx, owner, req.ExpectedVersion)
 	if err != nil {
-		return "", err
+		return nil, mapRepoError(err)
+	}
+	return utils.DomainCartToProto(savedCart), nil
+}
 
+func (s *service) CreateGuestSession(ctx context.Context) (*cartpb.GuestSession, error) {
+	sessionID, err := utils.NewGuestSessionID()
+	if err != nil {
+		return nil, err
 	}
-	itemIndex := utils.FindCartIndex(cart.Items, req.ProductId)
-	if itemIndex < 0 {
-		return "", errors.New("there is no item found")
+	if err := s.sessions.Create(ctx, sessionID); err != nil {
+		return nil, err
 	}
-	if len(cart.Items) <= 1 {
-		log.Println("entire cart boom")
-		err := s.repo.DeleteCart(ctx, email)
-		if err != nil {
-			return "", err
+	return &cartpb.GuestSession{
+		SessionId: sessionID,
+		ExpiresAt: timestamppb.New(time.Now().Add(guestSessionRepo.SessionTTL)),
+	}, nil
+}
 
-		}
-		return "deleted successfully", nil
+// MergeCart deletes the guest session along with its cart, so repeating a
+// merge fails with NotFound instead of adding the guest items twice. The
+// session is checked by the merge itself, so a guest change racing with it
+// cannot recreate the deleted guest cart.
+func (s *service) MergeCart(ctx context.Context, owner string, req *cartpb.MergeCartRequest) (*cartpb.CartResponse, error) {
+	if owner == "" || utils.IsGuestOwner(owner) {
+		return nil, status.Error(codes.Unauthenticated, "sign in to merge a guest cart")
+	}
+	strategy := req.Strategy
+	if strategy == "" {
+		strategy = s.mergeStrategy
+	}
 
+	guestCart, err := s.repo.GetCart(ctx, utils.GuestOwner(req.SessionId))
+	if err != nil {
+		return nil, err
+	}
+	limits, err := s.mergeLimits(ctx, guestCart)
+	if err != nil {
+		return nil, err
+	}
+	cart, err := s.repo.MergeGuestCart(ctx, owner, req.SessionId, strategy, limits)
+	if errors.Is(err, cartRepo.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, mapRepoError(err)
 	}
-	cart.Items = append(cart.Items[:itemIndex], cart.Items[itemIndex+1:]...)
-	_, err = s.repo.AddToCart(ctx, email, cart)
+	return utils.DomainCartToProto(cart), nil
+}
 
+// mergeLimits returns the units available of each item in the guest cart, so
+// a merge cannot take a cart past the stock AddToCart would allow. Items the
+// catalog no longer has get a limit of zero.
+func (s *service) mergeLimits(ctx context.Context, guestCart *domain.Cart) (map[string]int32, error) {
+	limits := make(map[string]int32, len(guestCart.Items))
+	if len(guestCart.Items) == 0 {
+		return limits, nil
+	}
+	keys := make([]*cartpb.ProductKey, 0, len(guestCart.Items))
+	for _, item := range guestCart.Items {
+		keys = append(keys, &cartpb.ProductKey{Category: item.Category, ProductId: item.ProductID})
+		limits[item.ProductID] = 0
+	}
+	products, err := s.productClient.BatchGetProducts(ctx, keys)
 	if err != nil {
-		return "", err
+		return nil, status.Errorf(codes.Unavailable, "failed to check stock of the guest cart: %v", err)
+	}
+	for _, product := range products {
+		if _, ok := limits[product.ProductId]; ok {
+			limits[product.ProductId] = product.Available
+		}
+	}
+	return limits, nil
+}
 
+func mapRepoError(err error) error {
+	switch {
+	case errors.Is(err, cartRepo.ErrVersionMismatch), errors.Is(err, cartRepo.ErrCartConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, cartRepo.ErrItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, cartRepo.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, cartRepo.ErrSessionNotFound):
+		return status.Error(codes.Unauthenticated, err.Error())
 	}
-	return "deleted successfully", nil
+	return err
 }
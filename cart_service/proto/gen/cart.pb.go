@@ -25,12 +25,15 @@ const (
 )
 
 type AddToCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Category  string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// When set, the add only applies to this version of the cart and fails
+	// with a conflict otherwise. 0 applies it to whatever version is current.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AddToCartRequest) Reset() {
@@ -84,8 +87,18 @@ func (x *AddToCartRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *AddToCartRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type GetCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When set, items whose product details changed are updated to the
+	// current price, name and image. Warnings are returned either way.
+	ApplyUpdates  bool `protobuf:"varint,1,opt,name=apply_updates,json=applyUpdates,proto3" json:"apply_updates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -120,12 +133,20 @@ func (*GetCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetCartRequest) GetApplyUpdates() bool {
+	if x != nil {
+		return x.ApplyUpdates
+	}
+	return false
+}
+
 type UpdateCartItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateCartItemRequest) Reset() {
@@ -172,11 +193,19 @@ func (x *UpdateCartItemRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *UpdateCartItemRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type RemoveFromCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RemoveFromCartRequest) Reset() {
@@ -216,10 +245,20 @@ func (x *RemoveFromCartRequest) GetProductId() string {
 	return ""
 }
 
+func (x *RemoveFromCartRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type ClearCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When set, the cart is only cleared if it is still at this version, e.g.
+	// the version that was checked out.
+	ExpectedVersion int64 `protobuf:"varint,1,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ClearCartRequest) Reset() {
@@ -252,6 +291,156 @@ func (*ClearCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *ClearCartRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type CreateGuestSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestSessionRequest) Reset() {
+	*x = CreateGuestSessionRequest{}
+	mi := &file_cart_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestSessionRequest) ProtoMessage() {}
+
+func (x *CreateGuestSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuestSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{5}
+}
+
+type MergeCartRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// How to combine an item that is in both carts: sum adds the quantities,
+	// max keeps the larger one and newest keeps the one changed last. Empty
+	// uses the service default.
+	Strategy      string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartRequest) Reset() {
+	*x = MergeCartRequest{}
+	mi := &file_cart_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartRequest) ProtoMessage() {}
+
+func (x *MergeCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MergeCartRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *MergeCartRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type GuestSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestSession) Reset() {
+	*x = GuestSession{}
+	mi := &file_cart_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestSession) ProtoMessage() {}
+
+func (x *GuestSession) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestSession.ProtoReflect.Descriptor instead.
+func (*GuestSession) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GuestSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GuestSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type CartItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -267,7 +456,7 @@ type CartItem struct {
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_cart_proto_msgTypes[5]
+	mi := &file_cart_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +468,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[5]
+	mi := &file_cart_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +481,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{5}
+	return file_cart_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CartItem) GetProductId() string {
@@ -345,20 +534,31 @@ func (x *CartItem) GetSubtotal() float64 {
 }
 
 type CartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Items         []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	Subtotal      float64                `protobuf:"fixed64,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Email      string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Items      []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TotalItems int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	Subtotal   float64                `protobuf:"fixed64,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// version increases with every change to the cart; send it back as
+	// expected_version to make the next change conditional. Refreshing item
+	// details on a read with apply_updates does not change it.
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// warnings flag items that no longer match the product catalog. They are
+	// only filled when reading the cart.
+	Warnings []*CartItemWarning `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// revalidated is set when a read checked the cart against the catalog, so
+	// no warnings means none apply. It is false when the catalog could not be
+	// reached, and on responses to changes, which are not checked.
+	Revalidated   bool `protobuf:"varint,9,opt,name=revalidated,proto3" json:"revalidated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartResponse) Reset() {
 	*x = CartResponse{}
-	mi := &file_cart_proto_msgTypes[6]
+	mi := &file_cart_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +570,7 @@ func (x *CartResponse) String() string {
 func (*CartResponse) ProtoMessage() {}
 
 func (x *CartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[6]
+	mi := &file_cart_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +583,7 @@ func (x *CartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartResponse.ProtoReflect.Descriptor instead.
 func (*CartResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{6}
+	return file_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CartResponse) GetEmail() string {
@@ -428,6 +628,113 @@ func (x *CartResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CartResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CartResponse) GetWarnings() []*CartItemWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *CartResponse) GetRevalidated() bool {
+	if x != nil {
+		return x.Revalidated
+	}
+	return false
+}
+
+type CartItemWarning struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// price_changed, product_unavailable, out_of_stock or insufficient_stock.
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The price stored in the cart and the current catalog price.
+	CartPrice     float64 `protobuf:"fixed64,4,opt,name=cart_price,json=cartPrice,proto3" json:"cart_price,omitempty"`
+	CurrentPrice  float64 `protobuf:"fixed64,5,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	Available     int32   `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItemWarning) Reset() {
+	*x = CartItemWarning{}
+	mi := &file_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemWarning) ProtoMessage() {}
+
+func (x *CartItemWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemWarning.ProtoReflect.Descriptor instead.
+func (*CartItemWarning) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CartItemWarning) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartItemWarning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CartItemWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CartItemWarning) GetCartPrice() float64 {
+	if x != nil {
+		return x.CartPrice
+	}
+	return 0
+}
+
+func (x *CartItemWarning) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *CartItemWarning) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
 type CartStandardResponse struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	Success    bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -436,6 +743,7 @@ type CartStandardResponse struct {
 	// Types that are valid to be assigned to Result:
 	//
 	//	*CartStandardResponse_CartData
+	//	*CartStandardResponse_GuestSession
 	Result        isCartStandardResponse_Result `protobuf_oneof:"result"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -443,7 +751,7 @@ type CartStandardResponse struct {
 
 func (x *CartStandardResponse) Reset() {
 	*x = CartStandardResponse{}
-	mi := &file_cart_proto_msgTypes[7]
+	mi := &file_cart_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +763,7 @@ func (x *CartStandardResponse) String() string {
 func (*CartStandardResponse) ProtoMessage() {}
 
 func (x *CartStandardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[7]
+	mi := &file_cart_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +776,7 @@ func (x *CartStandardResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartStandardResponse.ProtoReflect.Descriptor instead.
 func (*CartStandardResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{7}
+	return file_cart_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CartStandardResponse) GetSuccess() bool {
@@ -508,6 +816,15 @@ func (x *CartStandardResponse) GetCartData() *CartResponse {
 	return nil
 }
 
+func (x *CartStandardResponse) GetGuestSession() *GuestSession {
+	if x != nil {
+		if x, ok := x.Result.(*CartStandardResponse_GuestSession); ok {
+			return x.GuestSession
+		}
+	}
+	return nil
+}
+
 type isCartStandardResponse_Result interface {
 	isCartStandardResponse_Result()
 }
@@ -516,28 +833,49 @@ type CartStandardResponse_CartData struct {
 	CartData *CartResponse `protobuf:"bytes,4,opt,name=cart_data,json=cartData,proto3,oneof"`
 }
 
+type CartStandardResponse_GuestSession struct {
+	GuestSession *GuestSession `protobuf:"bytes,5,opt,name=guest_session,json=guestSession,proto3,oneof"`
+}
+
 func (*CartStandardResponse_CartData) isCartStandardResponse_Result() {}
 
+func (*CartStandardResponse_GuestSession) isCartStandardResponse_Result() {}
+
 var File_cart_proto protoreflect.FileDescriptor
 
 const file_cart_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"cart.proto\x12\fcart_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\x84\x01\n" +
+	"cart.proto\x12\fcart_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xb8\x01\n" +
 	"\x10AddToCartRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\x12#\n" +
 	"\bcategory\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcategory\x12#\n" +
-	"\bquantity\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\"\x10\n" +
-	"\x0eGetCartRequest\"d\n" +
+	"\bquantity\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\x122\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fexpectedVersion\"5\n" +
+	"\x0eGetCartRequest\x12#\n" +
+	"\rapply_updates\x18\x01 \x01(\bR\fapplyUpdates\"\x98\x01\n" +
 	"\x15UpdateCartItemRequest\x12&\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\x12#\n" +
-	"\bquantity\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bquantity\"?\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bquantity\x122\n" +
+	"\x10expected_version\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fexpectedVersion\"s\n" +
 	"\x15RemoveFromCartRequest\x12&\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\"\x12\n" +
-	"\x10ClearCartRequest\"\xd3\x01\n" +
+	"product_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\x122\n" +
+	"\x10expected_version\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fexpectedVersion\"F\n" +
+	"\x10ClearCartRequest\x122\n" +
+	"\x10expected_version\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fexpectedVersion\"\x1b\n" +
+	"\x19CreateGuestSessionRequest\"s\n" +
+	"\x10MergeCartRequest\x12(\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\tsessionId\x125\n" +
+	"\bstrategy\x18\x02 \x01(\tB\x19\xfaB\x16r\x14R\x00R\x03sumR\x03maxR\x06newestR\bstrategy\"h\n" +
+	"\fGuestSession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xd3\x01\n" +
 	"\bCartItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
@@ -546,7 +884,7 @@ const file_cart_proto_rawDesc = "" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
 	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1b\n" +
 	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x1a\n" +
-	"\bsubtotal\x18\a \x01(\x01R\bsubtotal\"\x85\x02\n" +
+	"\bsubtotal\x18\a \x01(\x01R\bsubtotal\"\xfc\x02\n" +
 	"\fCartResponse\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12,\n" +
 	"\x05items\x18\x02 \x03(\v2\x16.cart_service.CartItemR\x05items\x12\x1f\n" +
@@ -556,20 +894,35 @@ const file_cart_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb0\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x129\n" +
+	"\bwarnings\x18\b \x03(\v2\x1d.cart_service.CartItemWarningR\bwarnings\x12 \n" +
+	"\vrevalidated\x18\t \x01(\bR\vrevalidated\"\xc0\x01\n" +
+	"\x0fCartItemWarning\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"cart_price\x18\x04 \x01(\x01R\tcartPrice\x12#\n" +
+	"\rcurrent_price\x18\x05 \x01(\x01R\fcurrentPrice\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\x05R\tavailable\"\xf3\x01\n" +
 	"\x14CartStandardResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vstatus_code\x18\x03 \x01(\x05R\n" +
 	"statusCode\x129\n" +
-	"\tcart_data\x18\x04 \x01(\v2\x1a.cart_service.CartResponseH\x00R\bcartDataB\b\n" +
-	"\x06result2\xa1\x04\n" +
+	"\tcart_data\x18\x04 \x01(\v2\x1a.cart_service.CartResponseH\x00R\bcartData\x12A\n" +
+	"\rguest_session\x18\x05 \x01(\v2\x1a.cart_service.GuestSessionH\x00R\fguestSessionB\b\n" +
+	"\x06result2\x8e\x06\n" +
 	"\vCartService\x12e\n" +
 	"\tAddToCart\x12\x1e.cart_service.AddToCartRequest\x1a\".cart_service.CartStandardResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/cart/add\x12Z\n" +
 	"\aGetCart\x12\x1c.cart_service.GetCartRequest\x1a\".cart_service.CartStandardResponse\"\r\x82\xd3\xe4\x93\x02\a\x12\x05/cart\x12r\n" +
 	"\x0eUpdateCartItem\x12#.cart_service.UpdateCartItemRequest\x1a\".cart_service.CartStandardResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/cart/update\x12r\n" +
 	"\x0eRemoveFromCart\x12#.cart_service.RemoveFromCartRequest\x1a\".cart_service.CartStandardResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01**\f/cart/remove\x12g\n" +
-	"\tClearCart\x12\x1e.cart_service.ClearCartRequest\x1a\".cart_service.CartStandardResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01**\v/cart/clearB\xae\x01\n" +
+	"\tClearCart\x12\x1e.cart_service.ClearCartRequest\x1a\".cart_service.CartStandardResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01**\v/cart/clear\x12\x81\x01\n" +
+	"\x12CreateGuestSession\x12'.cart_service.CreateGuestSessionRequest\x1a\".cart_service.CartStandardResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/cart/guest-session\x12g\n" +
+	"\tMergeCart\x12\x1e.cart_service.MergeCartRequest\x1a\".cart_service.CartStandardResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/cart/mergeB\xae\x01\n" +
 	"\x10com.cart_serviceB\tCartProtoP\x01ZCgithub.com/Likhon22/ecom_microservice/cart_service/proto/gen;cartpb\xa2\x02\x03CXX\xaa\x02\vCartService\xca\x02\vCartService\xe2\x02\x17CartService\\GPBMetadata\xea\x02\vCartServiceb\x06proto3"
 
 var (
@@ -584,38 +937,49 @@ func file_cart_proto_rawDescGZIP() []byte {
 	return file_cart_proto_rawDescData
 }
 
-var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_cart_proto_goTypes = []any{
-	(*AddToCartRequest)(nil),      // 0: cart_service.AddToCartRequest
-	(*GetCartRequest)(nil),        // 1: cart_service.GetCartRequest
-	(*UpdateCartItemRequest)(nil), // 2: cart_service.UpdateCartItemRequest
-	(*RemoveFromCartRequest)(nil), // 3: cart_service.RemoveFromCartRequest
-	(*ClearCartRequest)(nil),      // 4: cart_service.ClearCartRequest
-	(*CartItem)(nil),              // 5: cart_service.CartItem
-	(*CartResponse)(nil),          // 6: cart_service.CartResponse
-	(*CartStandardResponse)(nil),  // 7: cart_service.CartStandardResponse
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*AddToCartRequest)(nil),          // 0: cart_service.AddToCartRequest
+	(*GetCartRequest)(nil),            // 1: cart_service.GetCartRequest
+	(*UpdateCartItemRequest)(nil),     // 2: cart_service.UpdateCartItemRequest
+	(*RemoveFromCartRequest)(nil),     // 3: cart_service.RemoveFromCartRequest
+	(*ClearCartRequest)(nil),          // 4: cart_service.ClearCartRequest
+	(*CreateGuestSessionRequest)(nil), // 5: cart_service.CreateGuestSessionRequest
+	(*MergeCartRequest)(nil),          // 6: cart_service.MergeCartRequest
+	(*GuestSession)(nil),              // 7: cart_service.GuestSession
+	(*CartItem)(nil),                  // 8: cart_service.CartItem
+	(*CartResponse)(nil),              // 9: cart_service.CartResponse
+	(*CartItemWarning)(nil),           // 10: cart_service.CartItemWarning
+	(*CartStandardResponse)(nil),      // 11: cart_service.CartStandardResponse
+	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
 }
 var file_cart_proto_depIdxs = []int32{
-	5, // 0: cart_service.CartResponse.items:type_name -> cart_service.CartItem
-	8, // 1: cart_service.CartResponse.created_at:type_name -> google.protobuf.Timestamp
-	8, // 2: cart_service.CartResponse.updated_at:type_name -> google.protobuf.Timestamp
-	6, // 3: cart_service.CartStandardResponse.cart_data:type_name -> cart_service.CartResponse
-	0, // 4: cart_service.CartService.AddToCart:input_type -> cart_service.AddToCartRequest
-	1, // 5: cart_service.CartService.GetCart:input_type -> cart_service.GetCartRequest
-	2, // 6: cart_service.CartService.UpdateCartItem:input_type -> cart_service.UpdateCartItemRequest
-	3, // 7: cart_service.CartService.RemoveFromCart:input_type -> cart_service.RemoveFromCartRequest
-	4, // 8: cart_service.CartService.ClearCart:input_type -> cart_service.ClearCartRequest
-	7, // 9: cart_service.CartService.AddToCart:output_type -> cart_service.CartStandardResponse
-	7, // 10: cart_service.CartService.GetCart:output_type -> cart_service.CartStandardResponse
-	7, // 11: cart_service.CartService.UpdateCartItem:output_type -> cart_service.CartStandardResponse
-	7, // 12: cart_service.CartService.RemoveFromCart:output_type -> cart_service.CartStandardResponse
-	7, // 13: cart_service.CartService.ClearCart:output_type -> cart_service.CartStandardResponse
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	12, // 0: cart_service.GuestSession.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 1: cart_service.CartResponse.items:type_name -> cart_service.CartItem
+	12, // 2: cart_service.CartResponse.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: cart_service.CartResponse.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 4: cart_service.CartResponse.warnings:type_name -> cart_service.CartItemWarning
+	9,  // 5: cart_service.CartStandardResponse.cart_data:type_name -> cart_service.CartResponse
+	7,  // 6: cart_service.CartStandardResponse.guest_session:type_name -> cart_service.GuestSession
+	0,  // 7: cart_service.CartService.AddToCart:input_type -> cart_service.AddToCartRequest
+	1,  // 8: cart_service.CartService.GetCart:input_type -> cart_service.GetCartRequest
+	2,  // 9: cart_service.CartService.UpdateCartItem:input_type -> cart_service.UpdateCartItemRequest
+	3,  // 10: cart_service.CartService.RemoveFromCart:input_type -> cart_service.RemoveFromCartRequest
+	4,  // 11: cart_service.CartService.ClearCart:input_type -> cart_service.ClearCartRequest
+	5,  // 12: cart_service.CartService.CreateGuestSession:input_type -> cart_service.CreateGuestSessionRequest
+	6,  // 13: cart_service.CartService.MergeCart:input_type -> cart_service.MergeCartRequest
+	11, // 14: cart_service.CartService.AddToCart:output_type -> cart_service.CartStandardResponse
+	11, // 15: cart_service.CartService.GetCart:output_type -> cart_service.CartStandardResponse
+	11, // 16: cart_service.CartService.UpdateCartItem:output_type -> cart_service.CartStandardResponse
+	11, // 17: cart_service.CartService.RemoveFromCart:output_type -> cart_service.CartStandardResponse
+	11, // 18: cart_service.CartService.ClearCart:output_type -> cart_service.CartStandardResponse
+	11, // 19: cart_service.CartService.CreateGuestSession:output_type -> cart_service.CartStandardResponse
+	11, // 20: cart_service.CartService.MergeCart:output_type -> cart_service.CartStandardResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cart_proto_init() }
@@ -623,8 +987,9 @@ func file_cart_proto_init() {
 	if File_cart_proto != nil {
 		return
 	}
-	file_cart_proto_msgTypes[7].OneofWrappers = []any{
+	file_cart_proto_msgTypes[11].OneofWrappers = []any{
 		(*CartStandardResponse_CartData)(nil),
+		(*CartStandardResponse_GuestSession)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -632,7 +997,7 @@ func file_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_proto_rawDesc), len(file_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
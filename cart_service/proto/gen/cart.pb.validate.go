@@ -90,6 +90,17 @@ func (m *AddToCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetExpectedVersion() < 0 {
+		err := AddToCartRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return AddToCartRequestMultiError(errors)
 	}
@@ -190,6 +201,8 @@ func (m *GetCartRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ApplyUpdates
+
 	if len(errors) > 0 {
 		return GetCartRequestMultiError(errors)
 	}
@@ -312,6 +325,17 @@ func (m *UpdateCartItemRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetExpectedVersion() < 0 {
+		err := UpdateCartItemRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return UpdateCartItemRequestMultiError(errors)
 	}
@@ -425,6 +449,17 @@ func (m *RemoveFromCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetExpectedVersion() < 0 {
+		err := RemoveFromCartRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return RemoveFromCartRequestMultiError(errors)
 	}
@@ -527,6 +562,17 @@ func (m *ClearCartRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetExpectedVersion() < 0 {
+		err := ClearCartRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ClearCartRequestMultiError(errors)
 	}
@@ -605,6 +651,367 @@ var _ interface {
 	ErrorName() string
 } = ClearCartRequestValidationError{}
 
+// Validate checks the field values on CreateGuestSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGuestSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGuestSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGuestSessionRequestMultiError, or nil if none found.
+func (m *CreateGuestSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGuestSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateGuestSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGuestSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateGuestSessionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateGuestSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGuestSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGuestSessionRequestMultiError) AllErrors() []error { return m }
+
+// CreateGuestSessionRequestValidationError is the validation error returned by
+// CreateGuestSessionRequest.Validate if the designated constraints aren't met.
+type CreateGuestSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGuestSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGuestSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGuestSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGuestSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGuestSessionRequestValidationError) ErrorName() string {
+	return "CreateGuestSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGuestSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGuestSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGuestSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGuestSessionRequestValidationError{}
+
+// Validate checks the field values on MergeCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeCartRequestMultiError, or nil if none found.
+func (m *MergeCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSessionId()); l < 1 || l > 100 {
+		err := MergeCartRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _MergeCartRequest_Strategy_InLookup[m.GetStrategy()]; !ok {
+		err := MergeCartRequestValidationError{
+			field:  "Strategy",
+			reason: "value must be in list [ sum max newest]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeCartRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeCartRequestMultiError) AllErrors() []error { return m }
+
+// MergeCartRequestValidationError is the validation error returned by
+// MergeCartRequest.Validate if the designated constraints aren't met.
+type MergeCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeCartRequestValidationError) ErrorName() string { return "MergeCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeCartRequestValidationError{}
+
+var _MergeCartRequest_Strategy_InLookup = map[string]struct{}{
+	"":       {},
+	"sum":    {},
+	"max":    {},
+	"newest": {},
+}
+
+// Validate checks the field values on GuestSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GuestSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuestSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GuestSessionMultiError, or
+// nil if none found.
+func (m *GuestSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuestSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GuestSessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GuestSessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GuestSessionValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GuestSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuestSessionMultiError is an error wrapping multiple validation errors
+// returned by GuestSession.ValidateAll() if the designated constraints aren't met.
+type GuestSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuestSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuestSessionMultiError) AllErrors() []error { return m }
+
+// GuestSessionValidationError is the validation error returned by
+// GuestSession.Validate if the designated constraints aren't met.
+type GuestSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuestSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuestSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuestSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuestSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuestSessionValidationError) ErrorName() string { return "GuestSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuestSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuestSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuestSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuestSessionValidationError{}
+
 // Validate checks the field values on CartItem with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -838,6 +1245,44 @@ func (m *CartResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Version
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Revalidated
+
 	if len(errors) > 0 {
 		return CartResponseMultiError(errors)
 	}
@@ -915,6 +1360,118 @@ var _ interface {
 	ErrorName() string
 } = CartResponseValidationError{}
 
+// Validate checks the field values on CartItemWarning with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CartItemWarning) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartItemWarning with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CartItemWarningMultiError, or nil if none found.
+func (m *CartItemWarning) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartItemWarning) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for CartPrice
+
+	// no validation rules for CurrentPrice
+
+	// no validation rules for Available
+
+	if len(errors) > 0 {
+		return CartItemWarningMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartItemWarningMultiError is an error wrapping multiple validation errors
+// returned by CartItemWarning.ValidateAll() if the designated constraints
+// aren't met.
+type CartItemWarningMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartItemWarningMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartItemWarningMultiError) AllErrors() []error { return m }
+
+// CartItemWarningValidationError is the validation error returned by
+// CartItemWarning.Validate if the designated constraints aren't met.
+type CartItemWarningValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartItemWarningValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartItemWarningValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartItemWarningValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartItemWarningValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartItemWarningValidationError) ErrorName() string { return "CartItemWarningValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartItemWarningValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartItemWarning.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartItemWarningValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartItemWarningValidationError{}
+
 // Validate checks the field values on CartStandardResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -985,6 +1542,47 @@ func (m *CartStandardResponse) validate(all bool) error {
 			}
 		}
 
+	case *CartStandardResponse_GuestSession:
+		if v == nil {
+			err := CartStandardResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGuestSession()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartStandardResponseValidationError{
+						field:  "GuestSession",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartStandardResponseValidationError{
+						field:  "GuestSession",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGuestSession()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartStandardResponseValidationError{
+					field:  "GuestSession",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
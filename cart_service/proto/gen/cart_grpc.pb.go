@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_AddToCart_FullMethodName      = "/cart_service.CartService/AddToCart"
-	CartService_GetCart_FullMethodName        = "/cart_service.CartService/GetCart"
-	CartService_UpdateCartItem_FullMethodName = "/cart_service.CartService/UpdateCartItem"
-	CartService_RemoveFromCart_FullMethodName = "/cart_service.CartService/RemoveFromCart"
-	CartService_ClearCart_FullMethodName      = "/cart_service.CartService/ClearCart"
+	CartService_AddToCart_FullMethodName          = "/cart_service.CartService/AddToCart"
+	CartService_GetCart_FullMethodName            = "/cart_service.CartService/GetCart"
+	CartService_UpdateCartItem_FullMethodName     = "/cart_service.CartService/UpdateCartItem"
+	CartService_RemoveFromCart_FullMethodName     = "/cart_service.CartService/RemoveFromCart"
+	CartService_ClearCart_FullMethodName          = "/cart_service.CartService/ClearCart"
+	CartService_CreateGuestSession_FullMethodName = "/cart_service.CartService/CreateGuestSession"
+	CartService_MergeCart_FullMethodName          = "/cart_service.CartService/MergeCart"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -40,6 +42,12 @@ type CartServiceClient interface {
 	RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*CartStandardResponse, error)
 	// Clear entire cart
 	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*CartStandardResponse, error)
+	// Start a guest session. Visitors who are not signed in send the returned
+	// session id in the x-cart-session header to use a cart of their own.
+	CreateGuestSession(ctx context.Context, in *CreateGuestSessionRequest, opts ...grpc.CallOption) (*CartStandardResponse, error)
+	// Fold a guest cart into the signed-in user's cart and delete it, e.g.
+	// right after login.
+	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*CartStandardResponse, error)
 }
 
 type cartServiceClient struct {
@@ -100,6 +108,26 @@ func (c *cartServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest,
 	return out, nil
 }
 
+func (c *cartServiceClient) CreateGuestSession(ctx context.Context, in *CreateGuestSessionRequest, opts ...grpc.CallOption) (*CartStandardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartStandardResponse)
+	err := c.cc.Invoke(ctx, CartService_CreateGuestSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*CartStandardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartStandardResponse)
+	err := c.cc.Invoke(ctx, CartService_MergeCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
@@ -114,6 +142,12 @@ type CartServiceServer interface {
 	RemoveFromCart(context.Context, *RemoveFromCartRequest) (*CartStandardResponse, error)
 	// Clear entire cart
 	ClearCart(context.Context, *ClearCartRequest) (*CartStandardResponse, error)
+	// Start a guest session. Visitors who are not signed in send the returned
+	// session id in the x-cart-session header to use a cart of their own.
+	CreateGuestSession(context.Context, *CreateGuestSessionRequest) (*CartStandardResponse, error)
+	// Fold a guest cart into the signed-in user's cart and delete it, e.g.
+	// right after login.
+	MergeCart(context.Context, *MergeCartRequest) (*CartStandardResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -139,6 +173,12 @@ func (UnimplementedCartServiceServer) RemoveFromCart(context.Context, *RemoveFro
 func (UnimplementedCartServiceServer) ClearCart(context.Context, *ClearCartRequest) (*CartStandardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
 }
+func (UnimplementedCartServiceServer) CreateGuestSession(context.Context, *CreateGuestSessionRequest) (*CartStandardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestSession not implemented")
+}
+func (UnimplementedCartServiceServer) MergeCart(context.Context, *MergeCartRequest) (*CartStandardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCart not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -250,6 +290,42 @@ func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_CreateGuestSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).CreateGuestSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_CreateGuestSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).CreateGuestSession(ctx, req.(*CreateGuestSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MergeCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MergeCart(ctx, req.(*MergeCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +353,14 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearCart",
 			Handler:    _CartService_ClearCart_Handler,
 		},
+		{
+			MethodName: "CreateGuestSession",
+			Handler:    _CartService_CreateGuestSession_Handler,
+		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _CartService_MergeCart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cart.proto",
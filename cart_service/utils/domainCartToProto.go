@@ -27,5 +27,21 @@ func DomainCartToProto(cart *domain.Cart) *cartpb.CartResponse {
 		Subtotal:   cart.Subtotal,
 		CreatedAt:  timestamppb.New(cart.CreatedAt),
 		UpdatedAt:  timestamppb.New(cart.UpdatedAt),
+		Version:    cart.Version,
 	}
 }
+
+func CartWarningsToProto(warnings []domain.CartItemWarning) []*cartpb.CartItemWarning {
+	pbWarnings := make([]*cartpb.CartItemWarning, 0, len(warnings))
+	for _, warning := range warnings {
+		pbWarnings = append(pbWarnings, &cartpb.CartItemWarning{
+			ProductId:    warning.ProductID,
+			Code:         warning.Code,
+			Message:      warning.Message,
+			CartPrice:    warning.CartPrice,
+			CurrentPrice: warning.CurrentPrice,
+			Available:    warning.Available,
+		})
+	}
+	return pbWarnings
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// GetIdempotencyKey returns the client's idempotency-key metadata, or an
+// empty string when none was sent.
+func GetIdempotencyKey(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	keys := md.Get("idempotency-key")
+	if len(keys) == 0 {
+		return "", nil
+	}
+	if len(keys[0]) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "idempotency-key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+	return keys[0], nil
+}
+
+// RequestHash fingerprints a request so a reused idempotency key can be told
+// apart from a genuine retry.
+func RequestHash(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GuestSessionHeader carries the session id of a visitor who is not signed in.
+const GuestSessionHeader = "x-cart-session"
+
+// guestOwnerPrefix marks guest cart owners. Emails cannot contain ':', so a
+// guest owner never matches a user.
+const guestOwnerPrefix = "guest:"
+
+type cartOwnerKey struct{}
+
+// GetUserEmailFromMetadata returns the caller email injected by the gateway.
+func GetUserEmailFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing authentication metadata")
+	}
+	emails := md.Get("x-user-email")
+	if len(emails) == 0 || emails[0] == "" {
+		return "", status.Error(codes.Unauthenticated, "user email not found in metadata")
+	}
+	return emails[0], nil
+}
+
+// GetGuestSessionFromMetadata returns the guest session id the client sent,
+// or an empty string when there is none.
+func GetGuestSessionFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	sessions := md.Get(GuestSessionHeader)
+	if len(sessions) == 0 {
+		return ""
+	}
+	return sessions[0]
+}
+
+// GuestOwner is the cart owner of a guest session. Carts, like idempotency
+// keys, are scoped by owner: the email of a signed-in user or this value.
+func GuestOwner(sessionID string) string {
+	return guestOwnerPrefix + sessionID
+}
+
+// NewGuestSessionID returns an unguessable session id; knowing it is all it
+// takes to use the guest cart.
+func NewGuestSessionID() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate session id: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// GuestSessionID returns the session id of a GuestOwner.
+func GuestSessionID(owner string) string {
+	return strings.TrimPrefix(owner, guestOwnerPrefix)
+}
+
+func IsGuestOwner(owner string) bool {
+	return strings.HasPrefix(owner, guestOwnerPrefix)
+}
+
+// WithCartOwner stores the owner of the caller's cart in ctx.
+func WithCartOwner(ctx context.Context, owner string) context.Context {
+	return context.WithValue(ctx, cartOwnerKey{}, owner)
+}
+
+// CartOwner returns the owner stored by the identity interceptor, or an
+// empty string when the call did not pass through it.
+func CartOwner(ctx context.Context) string {
+	owner, _ := ctx.Value(cartOwnerKey{}).(string)
+	return owner
+}
@@ -7,3 +7,11 @@ func CreateKey(email string) string {
 	return key
 
 }
+
+func CreateIdempotencyKey(email, operation, key string) string {
+	return fmt.Sprintf("idempotency:%s:%s:%s", operation, email, key)
+}
+
+func CreateGuestSessionKey(sessionID string) string {
+	return fmt.Sprintf("cart_session:%s", sessionID)
+}
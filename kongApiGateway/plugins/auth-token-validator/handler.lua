@@ -22,12 +22,21 @@ function AuthTokenValidator:access(conf)
 
   -- 2 Get cookie header
   local cookie_header = kong.request.get_header("cookie")
+  local access_token = extract_cookie(cookie_header, "access-token")
+
+  -- Routes open to guests pass requests without a token, but never a
+  -- client-supplied identity. A token that is sent must still be valid.
+  if not access_token and conf.allow_anonymous then
+    kong.service.request.clear_header("x-user-email")
+    kong.service.request.clear_header("x-user-role")
+    return
+  end
+
   if not cookie_header then
     return return_error(401, "No authentication cookies")
   end
 
   -- 3 Extract access token from cookie
-  local access_token = extract_cookie(cookie_header, "access-token")
   if not access_token then
     return return_error(401, "Access token not found")
   end
@@ -10,6 +10,12 @@ return {
               description = "JWT secret for access token signature verification"
             } 
           },
+          { allow_anonymous = {
+              type = "boolean",
+              default = false,
+              description = "Let requests without an access token through, e.g. for guest carts"
+            }
+          },
         },
       },
     },
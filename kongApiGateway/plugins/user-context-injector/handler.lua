@@ -28,6 +28,11 @@ function UserEmailInjector:access(conf)
   -- Set email header
   kong.service.request.set_header("x-user-email", payload.Email)
   kong.log.info("Injected user email: ", payload.Email)
+
+  -- Set role header so services can authorize admin-only RPCs
+  if payload.Role then
+    kong.service.request.set_header("x-user-role", payload.Role)
+  end
 end
 
 -- Just decode, don't verify signature
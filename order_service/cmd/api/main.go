@@ -5,6 +5,7 @@ import (
 	"log"
 	"order_service/internal/bootstrap"
 	"order_service/internal/config"
+	"os"
 	"os/signal"
 	"syscall"
 
@@ -15,9 +16,17 @@ func main() {
 	if err := godotenv.Load(); err != nil {
 		log.Println("Warning: .env file not found, using system environment variables")
 	}
-	cnf := config.GetConfig()
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(ctx, os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+		return
+	}
+
+	cnf := config.GetConfig()
 	app, err := bootstrap.InitializeApp(ctx, cnf)
 
 	if err != nil {
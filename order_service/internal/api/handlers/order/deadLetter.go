@@ -0,0 +1,52 @@
+package orderHandler
+
+import (
+	"context"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+)
+
+func (h *Handler) ListDeadLetters(ctx context.Context, req *orderpb.ListDeadLettersRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	if _, err := utils.GetUserEmail(ctx); err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	letters, err := h.deadLetterService.ListDeadLetters(ctx, utils.GetUserRole(ctx), req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "dead letters fetched successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_DeadLettersData{
+			DeadLettersData: letters,
+		},
+	}, nil
+}
+
+func (h *Handler) ReplayDeadLetter(ctx context.Context, req *orderpb.ReplayDeadLetterRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	letter, err := h.deadLetterService.ReplayDeadLetter(ctx, email, utils.GetUserRole(ctx), req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "dead letter replayed successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_DeadLetterData{
+			DeadLetterData: letter,
+		},
+	}, nil
+}
@@ -1,18 +1,256 @@
 package orderHandler
 
 import (
+	"context"
+	deadLetterService "order_service/internal/service/deadletter"
+	invoiceService "order_service/internal/service/invoice"
 	orderService "order_service/internal/service/order"
+	paymentService "order_service/internal/service/payment"
+	"order_service/internal/utils"
 	orderpb "order_service/proto/gen"
 )
 
 type Handler struct {
 	orderpb.UnimplementedOrderServiceServer
-	service orderService.Service
+	service           orderService.Service
+	paymentService    paymentService.Service
+	deadLetterService deadLetterService.Service
+	invoiceService    invoiceService.Service
 }
 
-func NewHandler(service orderService.Service) *Handler {
+func NewHandler(service orderService.Service, paymentService paymentService.Service, deadLetterService deadLetterService.Service, invoiceService invoiceService.Service) *Handler {
 	return &Handler{
-		service: service,
+		service:           service,
+		paymentService:    paymentService,
+		deadLetterService: deadLetterService,
+		invoiceService:    invoiceService,
 	}
 
 }
+
+func (h *Handler) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	idempotencyKey, err := utils.GetIdempotencyKey(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	order, err := h.service.CreateOrder(ctx, email, idempotencyKey, req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "order created successfully",
+		StatusCode: 201,
+		Result: &orderpb.StandardResponse_OrderCreateData{
+			OrderCreateData: order,
+		},
+	}, nil
+}
+
+func (h *Handler) Checkout(ctx context.Context, req *orderpb.CheckoutRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	order, err := h.service.Checkout(ctx, email, req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "checkout successful",
+		StatusCode: 201,
+		Result: &orderpb.StandardResponse_OrderCreateData{
+			OrderCreateData: order,
+		},
+	}, nil
+}
+
+func (h *Handler) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	order, err := h.service.GetOrder(ctx, email, req.OrderId)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "order fetched successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_OrderData{
+			OrderData: order,
+		},
+	}, nil
+}
+
+func (h *Handler) ListMyOrders(ctx context.Context, req *orderpb.ListMyOrdersRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	orders, err := h.service.ListMyOrders(ctx, email, req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "orders fetched successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_OrdersData{
+			OrdersData: orders,
+		},
+	}, nil
+}
+
+func (h *Handler) ListOrders(ctx context.Context, req *orderpb.ListOrdersRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	if _, err := utils.GetUserEmail(ctx); err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	orders, err := h.service.ListOrders(ctx, utils.GetUserRole(ctx), req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "orders fetched successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_OrdersData{
+			OrdersData: orders,
+		},
+	}, nil
+}
+
+func (h *Handler) GetOrderStatusHistory(ctx context.Context, req *orderpb.GetOrderStatusHistoryRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	history, err := h.service.GetStatusHistory(ctx, email, utils.GetUserRole(ctx), req.OrderId)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "order status history fetched successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_StatusHistoryData{
+			StatusHistoryData: history,
+		},
+	}, nil
+}
+
+func (h *Handler) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	order, err := h.service.CancelOrder(ctx, email, req.OrderId, req.Reason)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "order cancelled successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_OrderData{
+			OrderData: order,
+		},
+	}, nil
+}
+
+func (h *Handler) WatchOrder(req *orderpb.WatchOrderRequest, stream orderpb.OrderService_WatchOrderServer) error {
+	if err := req.ValidateAll(); err != nil {
+		return utils.MapError(err)
+	}
+	ctx := stream.Context()
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return utils.MapError(err)
+	}
+
+	err = h.service.WatchOrder(ctx, email, req.OrderId, stream.Send)
+	if err != nil {
+		return utils.MapError(err)
+	}
+	return nil
+}
+
+func (h *Handler) CreateShipment(ctx context.Context, req *orderpb.CreateShipmentRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	shipment, err := h.service.CreateShipment(ctx, email, utils.GetUserRole(ctx), req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "shipment created successfully",
+		StatusCode: 201,
+		Result: &orderpb.StandardResponse_ShipmentData{
+			ShipmentData: utils.ShipmentToProto(shipment),
+		},
+	}, nil
+}
+
+func (h *Handler) MarkShipmentDelivered(ctx context.Context, req *orderpb.MarkShipmentDeliveredRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	shipment, err := h.service.MarkShipmentDelivered(ctx, email, utils.GetUserRole(ctx), req.ShipmentId)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "shipment marked delivered",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_ShipmentData{
+			ShipmentData: utils.ShipmentToProto(shipment),
+		},
+	}, nil
+}
@@ -0,0 +1,30 @@
+package orderHandler
+
+import (
+	"context"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+)
+
+func (h *Handler) GetInvoice(ctx context.Context, req *orderpb.GetInvoiceRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	invoice, err := h.invoiceService.GetInvoice(ctx, email, utils.GetUserRole(ctx), req.OrderId)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "invoice fetched successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_InvoiceData{
+			InvoiceData: invoice,
+		},
+	}, nil
+}
@@ -0,0 +1,94 @@
+package orderHandler
+
+import (
+	"context"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+)
+
+func (h *Handler) InitiatePayment(ctx context.Context, req *orderpb.InitiatePaymentRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	payment, err := h.paymentService.InitiatePayment(ctx, email, req.OrderId)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "payment initiated successfully",
+		StatusCode: 201,
+		Result: &orderpb.StandardResponse_PaymentData{
+			PaymentData: utils.PaymentToProto(payment),
+		},
+	}, nil
+}
+
+func (h *Handler) ConfirmPayment(ctx context.Context, req *orderpb.ConfirmPaymentRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	payment, err := h.paymentService.ConfirmPayment(ctx, email, req.PaymentId, req.PaymentMethod)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "payment " + payment.Status,
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_PaymentData{
+			PaymentData: utils.PaymentToProto(payment),
+		},
+	}, nil
+}
+
+func (h *Handler) PaymentWebhook(ctx context.Context, req *orderpb.PaymentWebhookRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	if err := h.paymentService.HandleWebhook(ctx, []byte(req.Payload), req.Signature); err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "webhook processed",
+		StatusCode: 200,
+	}, nil
+}
+
+func (h *Handler) RefundOrder(ctx context.Context, req *orderpb.RefundOrderRequest) (*orderpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := utils.GetUserEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	refund, order, err := h.paymentService.RefundOrder(ctx, email, utils.GetUserRole(ctx), req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &orderpb.StandardResponse{
+		Success:    true,
+		Message:    "order refunded successfully",
+		StatusCode: 200,
+		Result: &orderpb.StandardResponse_RefundData{
+			RefundData: &orderpb.RefundOrderResponse{
+				Refund: utils.RefundToProto(refund),
+				Order:  utils.OrderToProto(order),
+			},
+		},
+	}, nil
+}
@@ -6,61 +6,222 @@ import (
 	"log"
 	"net"
 	orderHandler "order_service/internal/api/handlers/order"
+	cartclient "order_service/internal/clients/cart"
+	client "order_service/internal/clients/product"
+	userclient "order_service/internal/clients/user"
 	"order_service/internal/config"
 	"order_service/internal/infra"
+	"order_service/internal/interceptors"
+	"order_service/internal/invoice"
 	"order_service/internal/kafka"
+	"order_service/internal/outbox"
+	"order_service/internal/payment"
+	"order_service/internal/pubsub"
+	idempotencyRepo "order_service/internal/repo/idempotency"
+	invoiceRepo "order_service/internal/repo/invoice"
 	orderRepo "order_service/internal/repo/order"
+	outboxRepo "order_service/internal/repo/outbox"
+	paymentRepo "order_service/internal/repo/payment"
+	processedEventRepo "order_service/internal/repo/processedEvent"
+	deadLetterService "order_service/internal/service/deadletter"
+	invoiceService "order_service/internal/service/invoice"
 	orderService "order_service/internal/service/order"
+	paymentService "order_service/internal/service/payment"
+	"order_service/migrate"
 	orderpb "order_service/proto/gen"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 )
 
 type App struct {
-	server    *grpc.Server
-	listener  net.Listener
-	cnf       *config.Config
-	prodClose func() error
-	consClose func() error
-	dbClose   func() error
+	server             *grpc.Server
+	consumer           kafka.Consumer
+	relay              *outbox.Relay
+	service            orderService.Service
+	payments           paymentService.Service
+	listener           net.Listener
+	cnf                *config.Config
+	prodClose          func() error
+	consClose          func() error
+	deadLettersClose   func() error
+	dbClose            func() error
+	redisClose         func() error
+	productClientClose func() error
+	cartClientClose    func() error
+	userClientClose    func() error
 }
 
-func InitializeApp(ctx context.Context, cnf *config.Config) (*App, error) {
+// InitializeApp connects the app's dependencies. If it fails part way, the
+// ones already opened are closed again in reverse order.
+func InitializeApp(ctx context.Context, cnf *config.Config) (_ *App, err error) {
+	var closers []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}()
+
 	db, err := infra.ConnectDb(cnf.DBCnf)
 	if err != nil {
 		return nil, fmt.Errorf("database connection failed: %w", err)
 	}
+	closers = append(closers, db.Close)
+	if cnf.DBCnf.AutoMigrate {
+		if _, err := migrate.Up(ctx, db); err != nil {
+			return nil, fmt.Errorf("database migration failed: %w", err)
+		}
+	}
 
-	kfInfra := infra.NewKafkaInfra([]string{"localhost:9092"})
-	writer := kfInfra.Writer(kafka.OrderEventsTopic)
-	reader := kfInfra.Reader(kafka.OrderResultsTopic, "order_service_group")
+	kfCnf := cnf.KafkaCnf
+	kfInfra, err := infra.NewKafkaInfra(kfCnf)
+	if err != nil {
+		return nil, err
+	}
+	if err := kfInfra.Ping(ctx); err != nil {
+		return nil, err
+	}
+	if kfCnf.AutoCreateTopics {
+		err := kfInfra.EnsureTopics(ctx, kfCnf.Partitions, kfCnf.ReplicationFactor,
+			kfCnf.OrderEventsTopic, kfCnf.OrderResultsTopic, kfCnf.OrderResultsDeadLetterTopic)
+		if err != nil {
+			return nil, err
+		}
+	}
+	writer := kfInfra.Writer(kfCnf.OrderEventsTopic)
+	reader := kfInfra.Reader(kfCnf.OrderResultsTopic, kfCnf.ConsumerGroup)
+	retryPolicy := kafka.RetryPolicy{
+		MaxAttempts:    kfCnf.MaxAttempts,
+		InitialBackoff: kfCnf.InitialBackoff,
+		MaxBackoff:     kfCnf.MaxBackoff,
+	}
 
 	producer, prodClose := kafka.NewProducer(writer)
-	consumer, consClose := kafka.NewConsumer(reader)
+	closers = append(closers, prodClose)
+	consumer, consClose := kafka.NewConsumer(reader, kfInfra.Writer(kfCnf.OrderResultsDeadLetterTopic), retryPolicy, processedEventRepo.NewRepo(db))
+	closers = append(closers, consClose)
+	deadLetters, deadLettersClose := kafka.NewDeadLetterStore(kfInfra.Client(), kfInfra.PartitionReader,
+		kfCnf.OrderResultsDeadLetterTopic, kfInfra.Writer(kfCnf.OrderResultsTopic))
+	closers = append(closers, deadLettersClose)
+
+	productClient, closeProductClient, err := client.NewClient(ctx, cnf.Product_Service_Addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial product service: %w", err)
+	}
+	closers = append(closers, closeProductClient)
+	cartClient, closeCartClient, err := cartclient.NewClient(ctx, cnf.Cart_Service_Addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial cart service: %w", err)
+	}
+	closers = append(closers, closeCartClient)
+
+	userClient, closeUserClient, err := userclient.NewClient(ctx, cnf.User_Service_Addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial user service: %w", err)
+	}
+	closers = append(closers, closeUserClient)
+
+	provider, err := newPaymentProvider(cnf.PaymentCnf)
+	if err != nil {
+		return nil, err
+	}
+
+	rdb, err := infra.ConnectRedis(ctx, cnf.RedisCnf)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, rdb.Close)
+	notifier := pubsub.NewStatusNotifier(rdb)
 
 	repo := orderRepo.NewRepo(db)
-	service := orderService.NewService(producer, consumer, repo)
-	handler := orderHandler.NewHandler(service)
+	relay := outbox.NewRelay(outboxRepo.NewRepo(db), producer)
+	service := orderService.NewService(repo, idempotencyRepo.NewRepo(db), productClient, cartClient, notifier, cnf.IdempotencyTTL)
+	payments := paymentService.NewService(repo, paymentRepo.NewRepo(db), provider, notifier, cnf.PaymentCnf.Currency)
+	if fake, ok := provider.(*payment.FakeProvider); ok {
+		// The fake provider calls back in-process instead of through the
+		// webhook endpoint.
+		fake.SetWebhookSink(payments.HandleWebhook)
+	}
+	invoices := invoiceService.NewService(repo, invoiceRepo.NewRepo(db), userClient, invoiceService.Settings{
+		Seller: invoice.Seller{
+			Name:    cnf.InvoiceCnf.SellerName,
+			Address: cnf.InvoiceCnf.SellerAddress,
+		},
+		NumberPrefix: cnf.InvoiceCnf.NumberPrefix,
+		TaxRate:      cnf.InvoiceCnf.TaxRate,
+		Currency:     cnf.PaymentCnf.Currency,
+	})
+	handler := orderHandler.NewHandler(service, payments, deadLetterService.NewService(deadLetters), invoices)
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(interceptors.ErrorInterCeptor()),
+		grpc.StreamInterceptor(interceptors.ShutdownStreamInterceptor(ctx)),
+	)
 	lis, err := net.Listen("tcp", cnf.Addr)
 	if err != nil {
-		db.Close()
 		return nil, fmt.Errorf("lis connection failed: %w", err)
 	}
 	orderpb.RegisterOrderServiceServer(grpcServer, handler)
 
 	return &App{
-		server:    grpcServer,
-		listener:  lis,
-		cnf:       cnf,
-		prodClose: prodClose,
-		consClose: consClose,
-		dbClose:   db.Close,
+		server:             grpcServer,
+		consumer:           consumer,
+		relay:              relay,
+		service:            service,
+		payments:           payments,
+		listener:           lis,
+		cnf:                cnf,
+		prodClose:          prodClose,
+		consClose:          consClose,
+		deadLettersClose:   deadLettersClose,
+		dbClose:            db.Close,
+		redisClose:         rdb.Close,
+		productClientClose: closeProductClient,
+		cartClientClose:    closeCartClient,
+		userClientClose:    closeUserClient,
 	}, nil
 }
 
+func newPaymentProvider(cnf *config.PaymentConfig) (payment.PaymentProvider, error) {
+	switch cnf.Provider {
+	case "fake":
+		if !cnf.AllowFake {
+			return nil, fmt.Errorf("payment provider %q is not allowed without PAYMENT_ALLOW_FAKE", cnf.Provider)
+		}
+		return payment.NewFakeProvider(cnf.WebhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cnf.Provider)
+	}
+}
+
 func (a *App) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		a.consumer.StartResultListener(ctx, a.service.HandleValidationResult)
+	}()
+	go func() {
+		defer wg.Done()
+		a.relay.Run(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		a.service.RunIdempotencySweeper(ctx, time.Hour)
+	}()
+	go func() {
+		defer wg.Done()
+		a.payments.RunRefundReconciler(ctx, time.Minute)
+	}()
+	go func() {
+		defer wg.Done()
+		a.consumer.RunEventLogSweeper(ctx, time.Hour, a.cnf.KafkaCnf.ProcessedEventRetention)
+	}()
+
 	go func() {
 		if err := a.server.Serve(a.listener); err != nil {
 			log.Fatalf("failed to serve gRPC: %v", err)
@@ -70,7 +231,13 @@ func (a *App) Run(ctx context.Context) {
 	<-ctx.Done()
 
 	a.server.GracefulStop()
+	wg.Wait()
 	a.prodClose()
 	a.consClose()
+	a.deadLettersClose()
 	a.dbClose()
+	a.redisClose()
+	a.productClientClose()
+	a.cartClientClose()
+	a.userClientClose()
 }
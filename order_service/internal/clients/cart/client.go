@@ -0,0 +1,72 @@
+package cartclient
+
+import (
+	"context"
+	"fmt"
+	cartpb "order_service/proto/gen/cart"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type client struct {
+	stub cartpb.CartServiceClient
+	conn *grpc.ClientConn
+}
+
+type Client interface {
+	GetCart(ctx context.Context, email string) (*cartpb.CartResponse, error)
+	// ClearCart empties the cart if it is still at expectedVersion; 0
+	// clears whatever version is current.
+	ClearCart(ctx context.Context, email string, expectedVersion int64) error
+}
+
+func NewClient(ctx context.Context, clientAddr string) (Client, func() error, error) {
+
+	cartClient, err := grpc.DialContext(ctx, clientAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("grpc dial %s: %w", clientAddr, err)
+	}
+	return &client{
+		stub: cartpb.NewCartServiceClient(cartClient),
+		conn: cartClient,
+	}, cartClient.Close, nil
+
+}
+
+// withUser forwards the caller identity the same way the gateway does, since
+// the cart service keys carts by the x-user-email metadata.
+func withUser(ctx context.Context, email string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "x-user-email", email)
+}
+
+func (c *client) GetCart(ctx context.Context, email string) (*cartpb.CartResponse, error) {
+
+	resp, err := c.stub.GetCart(withUser(ctx, email), &cartpb.GetCartRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("failed to get cart: %s", resp.Message)
+	}
+	cart := resp.GetCartData()
+	if cart == nil {
+		return nil, fmt.Errorf("cart not found in response")
+	}
+	return cart, nil
+}
+
+func (c *client) ClearCart(ctx context.Context, email string, expectedVersion int64) error {
+
+	resp, err := c.stub.ClearCart(withUser(ctx, email), &cartpb.ClearCartRequest{ExpectedVersion: expectedVersion})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to clear cart: %s", resp.Message)
+	}
+	return nil
+}
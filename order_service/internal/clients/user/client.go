@@ -0,0 +1,50 @@
+package userclient
+
+import (
+	"context"
+	"fmt"
+	userpb "order_service/proto/gen/user"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type client struct {
+	stub userpb.UserServiceClient
+	conn *grpc.ClientConn
+}
+
+type Client interface {
+	GetCustomerByEmail(ctx context.Context, email string) (*userpb.CreateCustomerResponse, error)
+}
+
+// NewClient connects to user_service in the background, so the order service
+// starts without it; calls fail with Unavailable while it is unreachable.
+// Without an address every call fails that way.
+func NewClient(ctx context.Context, clientAddr string) (Client, func() error, error) {
+	if clientAddr == "" {
+		return unconfigured{}, func() error { return nil }, nil
+	}
+
+	userClient, err := grpc.DialContext(ctx, clientAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("grpc dial %s: %w", clientAddr, err)
+	}
+	return &client{
+		stub: userpb.NewUserServiceClient(userClient),
+		conn: userClient,
+	}, userClient.Close, nil
+
+}
+
+func (c *client) GetCustomerByEmail(ctx context.Context, email string) (*userpb.CreateCustomerResponse, error) {
+	return c.stub.GetCustomerByEmail(ctx, &userpb.GetCustomerByEmailRequest{Email: email})
+}
+
+type unconfigured struct{}
+
+func (unconfigured) GetCustomerByEmail(ctx context.Context, email string) (*userpb.CreateCustomerResponse, error) {
+	return nil, status.Error(codes.Unavailable, "user service is not configured")
+}
@@ -3,18 +3,28 @@ package config
 import (
 	"os"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
 type Config struct {
-	Version           string
-	ServiceName       string
-	Addr              string
-	User_Service_Addr string
-	DBCnf             *DBConfig
+	Version              string
+	ServiceName          string
+	Addr                 string
+	User_Service_Addr    string
+	Product_Service_Addr string
+	Cart_Service_Addr    string
+	DBCnf                *DBConfig
+	PaymentCnf           *PaymentConfig
+	KafkaCnf             *KafkaConfig
+	RedisCnf             *RedisConfig
+	InvoiceCnf           *InvoiceConfig
+	IdempotencyTTL       time.Duration
 }
 
+const defaultIdempotencyTTL = 24 * time.Hour
+
 var (
 	config *Config
 	once   sync.Once
@@ -26,13 +36,29 @@ func loadConfig() {
 	serviceName := os.Getenv("SERVICE_NAME")
 	addr := os.Getenv("ADDR")
 	user_service_addr := os.Getenv("USER_SERVICE_ADDR")
+	product_service_addr := os.Getenv("PRODUCT_SERVICE_ADDR")
+	cart_service_addr := os.Getenv("CART_SERVICE_ADDR")
+
+	idempotencyTTL := durationEnv("IDEMPOTENCY_TTL", defaultIdempotencyTTL)
+
+	paymentCnf, err := LoadPaymentConfig()
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid payment config")
+	}
 
 	config = &Config{
-		Version:           version,
-		ServiceName:       serviceName,
-		Addr:              addr,
-		User_Service_Addr: user_service_addr,
-		DBCnf:             LoadDBConfig(),
+		Version:              version,
+		ServiceName:          serviceName,
+		Addr:                 addr,
+		User_Service_Addr:    user_service_addr,
+		Product_Service_Addr: product_service_addr,
+		Cart_Service_Addr:    cart_service_addr,
+		DBCnf:                LoadDBConfig(),
+		PaymentCnf:           paymentCnf,
+		KafkaCnf:             LoadKafkaConfig(),
+		RedisCnf:             LoadRedisConfig(),
+		InvoiceCnf:           LoadInvoiceConfig(),
+		IdempotencyTTL:       idempotencyTTL,
 	}
 	validateMainConfig(config)
 }
@@ -42,7 +68,7 @@ func GetConfig() *Config {
 
 }
 func validateMainConfig(cfg *Config) {
-	if cfg.Version == "" || cfg.Addr == "" || cfg.ServiceName == "" {
+	if cfg.Version == "" || cfg.Addr == "" || cfg.ServiceName == "" || cfg.Product_Service_Addr == "" || cfg.Cart_Service_Addr == "" {
 		log.Fatal().Msg("missing core service environment variables")
 	}
 
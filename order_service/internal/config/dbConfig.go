@@ -13,6 +13,8 @@ type DBConfig struct {
 	DBDriver     string
 	MaxOpenConns int
 	MaxIdleConns int
+	// AutoMigrate applies pending embedded migrations at startup.
+	AutoMigrate bool
 }
 
 func LoadDBConfig() *DBConfig {
@@ -42,5 +44,6 @@ func LoadDBConfig() *DBConfig {
 		MaxIdleConns: dbMaxIdleConns,
 		DBName:       dbName,
 		DBDriver:     dbDriver,
+		AutoMigrate:  boolEnv("DB_AUTO_MIGRATE", true),
 	}
 }
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+)
+
+// InvoiceConfig describes the seller printed on invoices and how they are
+// numbered and taxed. Order prices include tax at TaxRate.
+type InvoiceConfig struct {
+	SellerName    string
+	SellerAddress string
+	NumberPrefix  string
+	TaxRate       float64
+}
+
+func LoadInvoiceConfig() *InvoiceConfig {
+	cnf := &InvoiceConfig{
+		SellerName:    stringEnv("INVOICE_SELLER_NAME", "Ecom Microservice"),
+		SellerAddress: os.Getenv("INVOICE_SELLER_ADDRESS"),
+		NumberPrefix:  stringEnv("INVOICE_NUMBER_PREFIX", "INV"),
+	}
+	if raw := os.Getenv("INVOICE_TAX_RATE"); raw != "" {
+		rate, err := strconv.ParseFloat(raw, 64)
+		if err != nil || rate < 0 || rate >= 1 {
+			log.Fatal().Str("INVOICE_TAX_RATE", raw).Msg("invoice tax rate must be a fraction in [0, 1)")
+		}
+		cnf.TaxRate = rate
+	}
+	return cnf
+}
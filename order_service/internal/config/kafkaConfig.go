@@ -0,0 +1,144 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// KafkaConfig holds the cluster connection, topic layout and the retry policy
+// consumers apply to each message before dead-lettering it.
+type KafkaConfig struct {
+	Brokers       []string
+	ConsumerGroup string
+
+	OrderEventsTopic            string
+	OrderResultsTopic           string
+	OrderResultsDeadLetterTopic string
+
+	// AutoCreateTopics creates missing topics at startup with the given
+	// partition and replication counts.
+	AutoCreateTopics  bool
+	Partitions        int
+	ReplicationFactor int
+
+	// SASLMechanism is empty, "plain", "scram-sha-256" or "scram-sha-512".
+	SASLMechanism string
+	SASLUsername  string
+	SASLPassword  string
+
+	TLSEnabled            bool
+	TLSCAFile             string
+	TLSInsecureSkipVerify bool
+
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// ProcessedEventRetention is how long processed event ids are kept to
+	// skip redeliveries. It should outlast the topics' retention.
+	ProcessedEventRetention time.Duration
+}
+
+func LoadKafkaConfig() *KafkaConfig {
+	cnf := &KafkaConfig{
+		Brokers:                     []string{"localhost:9092"},
+		ConsumerGroup:               stringEnv("KAFKA_CONSUMER_GROUP", "order_service_group"),
+		OrderEventsTopic:            stringEnv("KAFKA_ORDER_EVENTS_TOPIC", "order-events"),
+		OrderResultsTopic:           stringEnv("KAFKA_ORDER_RESULTS_TOPIC", "order-results"),
+		OrderResultsDeadLetterTopic: stringEnv("KAFKA_ORDER_RESULTS_DLQ_TOPIC", "order-results-dlq"),
+		AutoCreateTopics:            boolEnv("KAFKA_AUTO_CREATE_TOPICS", true),
+		Partitions:                  intEnv("KAFKA_TOPIC_PARTITIONS", 3),
+		ReplicationFactor:           intEnv("KAFKA_TOPIC_REPLICATION_FACTOR", 1),
+		SASLMechanism:               strings.ToLower(os.Getenv("KAFKA_SASL_MECHANISM")),
+		SASLUsername:                os.Getenv("KAFKA_SASL_USERNAME"),
+		SASLPassword:                os.Getenv("KAFKA_SASL_PASSWORD"),
+		TLSEnabled:                  boolEnv("KAFKA_TLS_ENABLED", false),
+		TLSCAFile:                   os.Getenv("KAFKA_TLS_CA_FILE"),
+		TLSInsecureSkipVerify:       boolEnv("KAFKA_TLS_INSECURE_SKIP_VERIFY", false),
+		MaxAttempts:                 intEnv("KAFKA_MAX_ATTEMPTS", 5),
+		InitialBackoff:              durationEnv("KAFKA_INITIAL_BACKOFF", 500*time.Millisecond),
+		MaxBackoff:                  durationEnv("KAFKA_MAX_BACKOFF", 30*time.Second),
+		ProcessedEventRetention:     durationEnv("KAFKA_PROCESSED_EVENT_RETENTION", 7*24*time.Hour),
+	}
+
+	if raw := os.Getenv("KAFKA_BROKERS"); raw != "" {
+		cnf.Brokers = nil
+		for _, broker := range strings.Split(raw, ",") {
+			if broker = strings.TrimSpace(broker); broker != "" {
+				cnf.Brokers = append(cnf.Brokers, broker)
+			}
+		}
+	}
+	validateKafkaConfig(cnf)
+	return cnf
+}
+
+func validateKafkaConfig(cnf *KafkaConfig) {
+	if len(cnf.Brokers) == 0 {
+		log.Fatal().Msg("KAFKA_BROKERS must list at least one broker")
+	}
+	if cnf.Partitions < 1 || cnf.ReplicationFactor < 1 || cnf.MaxAttempts < 1 {
+		log.Fatal().Msg("kafka partitions, replication factor and max attempts must be positive")
+	}
+	if cnf.MaxBackoff < cnf.InitialBackoff {
+		log.Fatal().Msg("KAFKA_MAX_BACKOFF must not be shorter than KAFKA_INITIAL_BACKOFF")
+	}
+	switch cnf.SASLMechanism {
+	case "":
+	case "plain", "scram-sha-256", "scram-sha-512":
+		if cnf.SASLUsername == "" || cnf.SASLPassword == "" {
+			log.Fatal().Msg("KAFKA_SASL_USERNAME and KAFKA_SASL_PASSWORD are required with KAFKA_SASL_MECHANISM")
+		}
+	default:
+		log.Fatal().Str("KAFKA_SASL_MECHANISM", cnf.SASLMechanism).Msg("unsupported sasl mechanism")
+	}
+}
+
+func stringEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func intEnv(key string, def int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		log.Fatal().Str(key, raw).Msg("invalid integer")
+	}
+	return v
+}
+
+func boolEnv(key string, def bool) bool {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		log.Fatal().Str(key, raw).Msg("invalid boolean")
+	}
+	return v
+}
+
+// durationEnv parses a positive duration such as "500ms" from key, falling
+// back to def when it is unset.
+func durationEnv(key string, def time.Duration) time.Duration {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return def
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		log.Fatal().Str(key, raw).Msg("invalid duration")
+	}
+	return d
+}
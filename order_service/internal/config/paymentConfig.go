@@ -0,0 +1,44 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+const fakeProvider = "fake"
+
+type PaymentConfig struct {
+	Provider      string
+	WebhookSecret string
+	Currency      string
+	// AllowFake permits the fake provider, which approves every payment. It
+	// is meant for local development only.
+	AllowFake bool
+}
+
+func LoadPaymentConfig() (*PaymentConfig, error) {
+	provider := os.Getenv("PAYMENT_PROVIDER")
+	webhookSecret := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	currency := os.Getenv("PAYMENT_CURRENCY")
+	allowFake := boolEnv("PAYMENT_ALLOW_FAKE", false)
+
+	if provider == "" {
+		return nil, errors.New("PAYMENT_PROVIDER is required")
+	}
+	if provider == fakeProvider && !allowFake {
+		return nil, errors.New("the fake payment provider requires PAYMENT_ALLOW_FAKE=true")
+	}
+	if currency == "" {
+		currency = "usd"
+	}
+	if webhookSecret == "" {
+		return nil, errors.New("PAYMENT_WEBHOOK_SECRET is required")
+	}
+
+	return &PaymentConfig{
+		Provider:      provider,
+		WebhookSecret: webhookSecret,
+		Currency:      currency,
+		AllowFake:     allowFake,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package config
+
+import "os"
+
+// RedisConfig locates the Redis instance used for order status pub/sub.
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+}
+
+func LoadRedisConfig() *RedisConfig {
+	return &RedisConfig{
+		Addr:     stringEnv("REDIS_ADDR", "localhost:6379"),
+		Password: os.Getenv("REDIS_PASSWORD"),
+		DB:       intEnv("REDIS_DB", 0),
+	}
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+const OperationCreateOrder = "CreateOrder"
+
+// IdempotencyKey remembers the first successful response to a client
+// supplied idempotency key so repeats of the same request replay it.
+type IdempotencyKey struct {
+	UserID      string    `db:"user_id" json:"user_id"`
+	Operation   string    `db:"operation" json:"operation"`
+	Key         string    `db:"idempotency_key" json:"idempotency_key"`
+	RequestHash string    `db:"request_hash" json:"request_hash"`
+	Response    []byte    `db:"response" json:"response"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	ExpiresAt   time.Time `db:"expires_at" json:"expires_at"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// Invoice is issued once per order and never changes afterwards: the buyer
+// details are a snapshot taken at issue time. Total is what the order cost,
+// tax included; Subtotal and TaxAmount split it at TaxRate.
+type Invoice struct {
+	ID           string      `db:"id" json:"id"`
+	OrderID      string      `db:"order_id" json:"order_id"`
+	Number       string      `db:"invoice_number" json:"invoice_number"`
+	BuyerName    string      `db:"buyer_name" json:"buyer_name"`
+	BuyerEmail   string      `db:"buyer_email" json:"buyer_email"`
+	BuyerPhone   string      `db:"buyer_phone" json:"buyer_phone"`
+	BuyerAddress string      `db:"buyer_address" json:"buyer_address"`
+	Currency     string      `db:"currency" json:"currency"`
+	Subtotal     float64     `db:"subtotal" json:"subtotal"`
+	TaxRate      float64     `db:"tax_rate" json:"tax_rate"`
+	TaxAmount    float64     `db:"tax_amount" json:"tax_amount"`
+	Total        float64     `db:"total" json:"total"`
+	Items        []OrderItem `db:"-" json:"items"`
+	// RefundedAmount is what the order's refunds took back so far. Like
+	// Items it comes from the order each time the invoice is shown.
+	RefundedAmount float64   `db:"-" json:"refunded_amount"`
+	IssuedAt       time.Time `db:"issued_at" json:"issued_at"`
+}
+
+// CanInvoice reports whether an order in status has been paid for and so may
+// be invoiced.
+func CanInvoice(status string) bool {
+	switch status {
+	case OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered, OrderStatusRefunded:
+		return true
+	}
+	return false
+}
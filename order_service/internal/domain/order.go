@@ -3,13 +3,49 @@ package domain
 import "time"
 
 type Order struct {
-	ID          string    `db:"id" json:"id"`
-	UserID      string    `db:"user_id" json:"user_id"`
-	ProductID   string    `db:"product_id" json:"product_id"`
-	Quantity    int       `db:"quantity" json:"quantity"`
-	TotalAmount float64   `db:"total_amount" json:"total_amount"`
-	Status      string    `db:"status" json:"status"`
-	PaymentID   string    `db:"payment_id,omitempty" json:"payment_id,omitempty"`
-	CreatedAt   time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+	ID             string      `db:"id" json:"id"`
+	UserID         string      `db:"user_id" json:"user_id"`
+	Items          []OrderItem `db:"-" json:"items"`
+	TotalAmount    float64     `db:"total_amount" json:"total_amount"`
+	RefundedAmount float64     `db:"refunded_amount" json:"refunded_amount"`
+	Status         string      `db:"status" json:"status"`
+	PaymentID      string      `db:"payment_id,omitempty" json:"payment_id,omitempty"`
+	ErrorMessage   string      `db:"error_message,omitempty" json:"error_message,omitempty"`
+	CreatedAt      time.Time   `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time   `db:"updated_at" json:"updated_at"`
+	// ShippingAddress is nil for orders placed before addresses were taken.
+	ShippingAddress *Address   `db:"-" json:"shipping_address,omitempty"`
+	Shipments       []Shipment `db:"-" json:"shipments"`
+}
+
+type OrderItem struct {
+	ID               string    `db:"id" json:"id"`
+	OrderID          string    `db:"order_id" json:"order_id"`
+	ProductID        string    `db:"product_id" json:"product_id"`
+	Category         string    `db:"category" json:"category"`
+	ProductName      string    `db:"product_name" json:"product_name"`
+	Quantity         int       `db:"quantity" json:"quantity"`
+	UnitPrice        float64   `db:"unit_price" json:"unit_price"`
+	LineTotal        float64   `db:"line_total" json:"line_total"`
+	RefundedQuantity int       `db:"refunded_quantity" json:"refunded_quantity"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+}
+
+// ChargedAmount is what the customer paid; nothing is charged until the
+// payment is captured.
+func (o *Order) ChargedAmount() float64 {
+	if o.PaymentID == "" {
+		return 0
+	}
+	return o.TotalAmount
+}
+
+// FullyRefunded reports whether every item has been refunded.
+func (o *Order) FullyRefunded() bool {
+	for _, item := range o.Items {
+		if item.RefundedQuantity < item.Quantity {
+			return false
+		}
+	}
+	return len(o.Items) > 0
 }
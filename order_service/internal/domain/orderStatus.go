@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	OrderStatusPending       = "pending"
+	OrderStatusValidated     = "validated"
+	OrderStatusPaid          = "paid"
+	OrderStatusPaymentFailed = "payment_failed"
+	OrderStatusShipped       = "shipped"
+	OrderStatusDelivered     = "delivered"
+	OrderStatusRejected      = "rejected"
+	OrderStatusCancelled     = "cancelled"
+	OrderStatusRefunded      = "refunded"
+)
+
+// ActorSystem marks transitions made by the service itself (e.g. consumers).
+const ActorSystem = "system"
+
+var ErrInvalidTransition = errors.New("invalid order status transition")
+
+// orderTransitions lists, for every status, the statuses it may move to.
+var orderTransitions = map[string][]string{
+	OrderStatusPending:   {OrderStatusValidated, OrderStatusRejected, OrderStatusCancelled},
+	OrderStatusValidated: {OrderStatusPaid, OrderStatusPaymentFailed, OrderStatusCancelled},
+	// A failed payment can be retried with a new payment.
+	OrderStatusPaymentFailed: {OrderStatusPaid, OrderStatusCancelled},
+	// Paid orders are not cancelled: that would keep the payment. They are
+	// refunded instead, which returns the money and the stock.
+	OrderStatusPaid:      {OrderStatusShipped, OrderStatusRefunded},
+	OrderStatusShipped:   {OrderStatusDelivered},
+	OrderStatusDelivered: {OrderStatusRefunded},
+}
+
+func CanTransition(from, to string) bool {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// watchEndingStatuses end a WatchOrder stream. Delivered orders can still be
+// refunded, but watchers follow an order to its customer, not through after
+// sales.
+var watchEndingStatuses = map[string]bool{
+	OrderStatusCancelled: true,
+	OrderStatusRejected:  true,
+	OrderStatusDelivered: true,
+	OrderStatusRefunded:  true,
+}
+
+func EndsWatch(status string) bool {
+	return watchEndingStatuses[status]
+}
+
+type StatusChange struct {
+	ID         int64     `db:"id" json:"id"`
+	OrderID    string    `db:"order_id" json:"order_id"`
+	FromStatus string    `db:"from_status" json:"from_status"`
+	ToStatus   string    `db:"to_status" json:"to_status"`
+	Actor      string    `db:"actor" json:"actor"`
+	Reason     string    `db:"reason" json:"reason"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "testing"
+
+func TestEndsWatch(t *testing.T) {
+	ending := []string{OrderStatusCancelled, OrderStatusRejected, OrderStatusDelivered, OrderStatusRefunded}
+	for _, status := range ending {
+		if !EndsWatch(status) {
+			t.Errorf("%s does not end the watch", status)
+		}
+	}
+	open := []string{OrderStatusPending, OrderStatusValidated, OrderStatusPaid, OrderStatusPaymentFailed, OrderStatusShipped}
+	for _, status := range open {
+		if EndsWatch(status) {
+			t.Errorf("%s ends the watch", status)
+		}
+	}
+
+	// A delivered order ends the watch even though it can still be refunded.
+	if !CanTransition(OrderStatusDelivered, OrderStatusRefunded) {
+		t.Error("delivered orders cannot be refunded")
+	}
+}
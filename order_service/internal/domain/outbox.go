@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+const (
+	EventTypeOrderCreated   = "OrderCreated"
+	EventTypeOrderCancelled = "OrderCancelled"
+	EventTypeOrderRefunded  = "OrderRefunded"
+	EventTypeOrderPaid      = "OrderPaid"
+	EventTypeOrderShipped   = "OrderShipped"
+)
+
+type OutboxMessage struct {
+	ID          int64  `db:"id" json:"id"`
+	AggregateID string `db:"aggregate_id" json:"aggregate_id"`
+	EventType   string `db:"event_type" json:"event_type"`
+	EventKey    string `db:"event_key" json:"event_key"`
+	Payload     []byte `db:"payload" json:"payload"`
+	// Envelope is the envelope version Payload was sealed with, or empty for
+	// bare payloads staged before events were enveloped.
+	Envelope      string    `db:"envelope" json:"envelope"`
+	Attempts      int       `db:"attempts" json:"attempts"`
+	NextAttemptAt time.Time `db:"next_attempt_at" json:"next_attempt_at"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+const (
+	PaymentStatusPending    = "pending"
+	PaymentStatusAuthorized = "authorized"
+	PaymentStatusCaptured   = "captured"
+	PaymentStatusFailed     = "failed"
+	// PaymentStatusVoided marks a payment whose order went away while it
+	// was in flight; its authorization was voided or its capture refunded.
+	PaymentStatusVoided = "voided"
+)
+
+// Payment is one attempt to pay for an order. An order may have several
+// failed attempts; orders.payment_id points at the captured one.
+type Payment struct {
+	ID              string    `db:"id" json:"id"`
+	OrderID         string    `db:"order_id" json:"order_id"`
+	Provider        string    `db:"provider" json:"provider"`
+	AuthorizationID string    `db:"authorization_id" json:"authorization_id"`
+	CaptureID       string    `db:"capture_id" json:"capture_id"`
+	Amount          float64   `db:"amount" json:"amount"`
+	Currency        string    `db:"currency" json:"currency"`
+	Status          string    `db:"status" json:"status"`
+	FailureReason   string    `db:"failure_reason" json:"failure_reason"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+const (
+	RefundStatusPending   = "pending"
+	RefundStatusCompleted = "completed"
+	RefundStatusFailed    = "failed"
+)
+
+// Refund gives back part or all of an order's captured payment. A pending
+// refund already counts against the order's refundable quantities.
+type Refund struct {
+	ID               string       `db:"id" json:"id"`
+	OrderID          string       `db:"order_id" json:"order_id"`
+	PaymentID        string       `db:"payment_id" json:"payment_id"`
+	ProviderRefundID string       `db:"provider_refund_id" json:"provider_refund_id"`
+	Amount           float64      `db:"amount" json:"amount"`
+	Reason           string       `db:"reason" json:"reason"`
+	Actor            string       `db:"actor" json:"actor"`
+	Status           string       `db:"status" json:"status"`
+	FailureReason    string       `db:"failure_reason" json:"failure_reason"`
+	Items            []RefundItem `db:"-" json:"items"`
+	CreatedAt        time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+type RefundItem struct {
+	ID          int64   `db:"id" json:"id"`
+	RefundID    string  `db:"refund_id" json:"refund_id"`
+	OrderItemID string  `db:"order_item_id" json:"order_item_id"`
+	ProductID   string  `db:"product_id" json:"product_id"`
+	Category    string  `db:"category" json:"category"`
+	Quantity    int     `db:"quantity" json:"quantity"`
+	Amount      float64 `db:"amount" json:"amount"`
+}
@@ -0,0 +1,10 @@
+package domain
+
+const (
+	RoleAdmin      = "admin"
+	RoleSuperAdmin = "superAdmin"
+)
+
+func IsAdmin(role string) bool {
+	return role == RoleAdmin || role == RoleSuperAdmin
+}
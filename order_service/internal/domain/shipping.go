@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// Address is the shipping destination snapshotted onto an order.
+type Address struct {
+	RecipientName string `db:"recipient_name" json:"recipient_name"`
+	Line1         string `db:"line1" json:"line1"`
+	Line2         string `db:"line2" json:"line2"`
+	City          string `db:"city" json:"city"`
+	State         string `db:"state" json:"state"`
+	PostalCode    string `db:"postal_code" json:"postal_code"`
+	Country       string `db:"country" json:"country"`
+	Phone         string `db:"phone" json:"phone"`
+}
+
+const (
+	ShipmentStatusInTransit = "in_transit"
+	ShipmentStatusDelivered = "delivered"
+)
+
+// Shipment is one parcel of an order handed to a carrier. An order is
+// delivered once all of its shipments are.
+type Shipment struct {
+	ID             string     `db:"id" json:"id"`
+	OrderID        string     `db:"order_id" json:"order_id"`
+	Carrier        string     `db:"carrier" json:"carrier"`
+	TrackingNumber string     `db:"tracking_number" json:"tracking_number"`
+	Status         string     `db:"status" json:"status"`
+	CreatedBy      string     `db:"created_by" json:"created_by"`
+	ShippedAt      time.Time  `db:"shipped_at" json:"shipped_at"`
+	DeliveredAt    *time.Time `db:"delivered_at" json:"delivered_at,omitempty"`
+	UpdatedAt      time.Time  `db:"updated_at" json:"updated_at"`
+}
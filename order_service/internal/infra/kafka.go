@@ -1,25 +1,63 @@
 package infra
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"order_service/internal/config"
+	"os"
+	"time"
+
 	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
 )
 
+const kafkaTimeout = 10 * time.Second
+
 type KafkaInfra struct {
-	Brokers []string
+	Brokers   []string
+	dialer    *kafka.Dialer
+	transport *kafka.Transport
 }
 
-func NewKafkaInfra(brokers []string) *KafkaInfra {
+// NewKafkaInfra prepares connections to the configured brokers, applying the
+// optional SASL and TLS settings to every reader, writer and admin client.
+func NewKafkaInfra(cnf *config.KafkaConfig) (*KafkaInfra, error) {
+	mechanism, err := saslMechanism(cnf)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := kafkaTLSConfig(cnf)
+	if err != nil {
+		return nil, err
+	}
 
 	return &KafkaInfra{
-		Brokers: brokers,
-	}
+		Brokers: cnf.Brokers,
+		dialer: &kafka.Dialer{
+			Timeout:       kafkaTimeout,
+			DualStack:     true,
+			SASLMechanism: mechanism,
+			TLS:           tlsConfig,
+		},
+		transport: &kafka.Transport{
+			DialTimeout: kafkaTimeout,
+			SASL:        mechanism,
+			TLS:         tlsConfig,
+		},
+	}, nil
 }
 
 func (k *KafkaInfra) Writer(topic string) *kafka.Writer {
 	return &kafka.Writer{
-		Addr:     kafka.TCP(k.Brokers...),
-		Topic:    topic,
-		Balancer: &kafka.LeastBytes{},
+		Addr:      kafka.TCP(k.Brokers...),
+		Topic:     topic,
+		Balancer:  &kafka.LeastBytes{},
+		Transport: k.transport,
 	}
 }
 
@@ -29,6 +67,99 @@ func (k *KafkaInfra) Reader(topic, groupId string) *kafka.Reader {
 		GroupID:  groupId,
 		Topic:    topic,
 		MaxBytes: 10e6,
+		Dialer:   k.dialer,
 	})
 	return r
 }
+
+// PartitionReader reads a single partition without a consumer group.
+func (k *KafkaInfra) PartitionReader(topic string, partition int) *kafka.Reader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:   k.Brokers,
+		Topic:     topic,
+		Partition: partition,
+		MaxBytes:  10e6,
+		Dialer:    k.dialer,
+	})
+}
+
+func (k *KafkaInfra) Client() *kafka.Client {
+	return &kafka.Client{
+		Addr:      kafka.TCP(k.Brokers...),
+		Timeout:   kafkaTimeout,
+		Transport: k.transport,
+	}
+}
+
+// Ping fails unless the cluster answers a metadata request in time.
+func (k *KafkaInfra) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, kafkaTimeout)
+	defer cancel()
+	if _, err := k.Client().Metadata(ctx, &kafka.MetadataRequest{}); err != nil {
+		return fmt.Errorf("kafka brokers %v unreachable: %w", k.Brokers, err)
+	}
+	return nil
+}
+
+// EnsureTopics creates the topics that do not exist yet. Existing topics are
+// left untouched, whatever their partition and replication counts.
+func (k *KafkaInfra) EnsureTopics(ctx context.Context, partitions, replicationFactor int, topics ...string) error {
+	configs := make([]kafka.TopicConfig, 0, len(topics))
+	for _, topic := range topics {
+		configs = append(configs, kafka.TopicConfig{
+			Topic:             topic,
+			NumPartitions:     partitions,
+			ReplicationFactor: replicationFactor,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, kafkaTimeout)
+	defer cancel()
+	resp, err := k.Client().CreateTopics(ctx, &kafka.CreateTopicsRequest{Topics: configs})
+	if err != nil {
+		return fmt.Errorf("failed to create kafka topics: %w", err)
+	}
+	for topic, err := range resp.Errors {
+		if err != nil && !errors.Is(err, kafka.TopicAlreadyExists) {
+			return fmt.Errorf("failed to create kafka topic %s: %w", topic, err)
+		}
+	}
+	return nil
+}
+
+func saslMechanism(cnf *config.KafkaConfig) (sasl.Mechanism, error) {
+	switch cnf.SASLMechanism {
+	case "":
+		return nil, nil
+	case "plain":
+		return plain.Mechanism{Username: cnf.SASLUsername, Password: cnf.SASLPassword}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, cnf.SASLUsername, cnf.SASLPassword)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, cnf.SASLUsername, cnf.SASLPassword)
+	default:
+		return nil, fmt.Errorf("unsupported sasl mechanism %q", cnf.SASLMechanism)
+	}
+}
+
+func kafkaTLSConfig(cnf *config.KafkaConfig) (*tls.Config, error) {
+	if !cnf.TLSEnabled {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cnf.TLSInsecureSkipVerify,
+	}
+	if cnf.TLSCAFile != "" {
+		pem, err := os.ReadFile(cnf.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kafka ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("kafka ca file %s has no certificates", cnf.TLSCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,27 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"order_service/internal/config"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ConnectRedis returns a client for cnf and fails unless Redis answers a ping.
+func ConnectRedis(ctx context.Context, cnf *config.RedisConfig) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     cnf.Addr,
+		Password: cnf.Password,
+		DB:       cnf.DB,
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		return nil, fmt.Errorf("redis %s unreachable: %w", cnf.Addr, err)
+	}
+	return rdb, nil
+}
@@ -0,0 +1,72 @@
+package interceptors
+
+import (
+	"context"
+	orderpb "order_service/proto/gen"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ErrorInterCeptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			statusCode := grpcCodeToHTTP(status.Code(err))
+			errorResponse := &orderpb.StandardResponse{
+				Success:    false,
+				Message:    err.Error(),
+				StatusCode: int32(statusCode),
+				Result:     nil,
+			}
+			return errorResponse, nil
+		}
+		return resp, nil
+	}
+
+}
+
+func grpcCodeToHTTP(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return 400
+	case codes.Unauthenticated:
+		return 401
+	case codes.PermissionDenied:
+		return 403
+	case codes.NotFound:
+		return 404
+	case codes.AlreadyExists:
+		return 409
+	case codes.FailedPrecondition:
+		return 409
+	case codes.Unavailable:
+		return 503
+	case codes.Internal:
+		return 500
+	default:
+		return 500
+	}
+}
+
+// ShutdownStreamInterceptor cancels streaming calls once ctx is done, so
+// long-lived streams such as WatchOrder do not hold up a graceful stop.
+func ShutdownStreamInterceptor(ctx context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		streamCtx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		stop := context.AfterFunc(ctx, cancel)
+		defer stop()
+		return handler(srv, &shutdownStream{ServerStream: ss, ctx: streamCtx})
+	}
+}
+
+type shutdownStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *shutdownStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,169 @@
+// Package invoice renders issued invoices as PDF documents.
+package invoice
+
+import (
+	"fmt"
+	"order_service/internal/domain"
+	"strings"
+)
+
+// Seller is the issuing business printed at the top of every invoice.
+type Seller struct {
+	Name    string
+	Address string
+}
+
+const (
+	marginLeft   = 50
+	marginRight  = pageWidth - 50
+	marginTop    = pageHeight - 60
+	marginBottom = 70
+	lineHeight   = 16
+
+	// Column positions of the line item table.
+	colProduct  = marginLeft
+	colQuantity = 330
+	colUnit     = 390
+	colTotal    = 480
+
+	// The invoice details sit right of the buyer block, whose lines are
+	// cut to stay clear of them.
+	colFieldLabel   = 300
+	colFieldValue   = 390
+	maxPartyLineLen = 45
+
+	maxProductNameLen = 48
+)
+
+// Filename is the suggested download name of the invoice PDF.
+func Filename(inv *domain.Invoice) string {
+	return inv.Number + ".pdf"
+}
+
+// RenderPDF lays out the invoice on as many A4 pages as its line items need.
+func RenderPDF(inv *domain.Invoice, seller Seller) []byte {
+	r := &renderer{doc: &pdfDocument{}, inv: inv}
+	r.newPage()
+
+	r.write(fontBold, 20, marginLeft, "INVOICE")
+	r.y -= 10
+	r.write(fontBold, 11, marginLeft, seller.Name)
+	for _, line := range splitLines(seller.Address) {
+		r.write(fontRegular, 10, marginLeft, line)
+	}
+	r.y -= 10
+
+	top := r.y
+	r.write(fontBold, 10, marginLeft, "Bill to")
+	buyer := append([]string{inv.BuyerName, inv.BuyerEmail, inv.BuyerPhone}, splitLines(inv.BuyerAddress)...)
+	for _, line := range buyer {
+		r.write(fontRegular, 10, marginLeft, truncate(line, maxPartyLineLen))
+	}
+	bottom := r.y
+
+	r.y = top
+	r.field("Invoice number", inv.Number)
+	r.field("Issue date", inv.IssuedAt.Format("2006-01-02"))
+	r.field("Order", inv.OrderID)
+	r.field("Currency", strings.ToUpper(inv.Currency))
+	r.y = min(r.y, bottom) - 20
+
+	r.tableHeader()
+	for _, item := range inv.Items {
+		if r.y < marginBottom+lineHeight {
+			r.newPage()
+			r.tableHeader()
+		}
+		name := item.ProductName
+		if item.RefundedQuantity > 0 {
+			name = fmt.Sprintf("%s (%d refunded)", name, item.RefundedQuantity)
+		}
+		r.doc.text(colProduct, r.y, fontRegular, 10, truncate(name, maxProductNameLen))
+		r.doc.text(colQuantity, r.y, fontRegular, 10, fmt.Sprintf("%d", item.Quantity))
+		r.doc.text(colUnit, r.y, fontRegular, 10, formatAmount(item.UnitPrice))
+		r.doc.text(colTotal, r.y, fontRegular, 10, formatAmount(item.LineTotal))
+		r.y -= lineHeight
+	}
+
+	if r.y < marginBottom+6*lineHeight {
+		r.newPage()
+	}
+	r.doc.line(colUnit, r.y+lineHeight/2, marginRight, r.y+lineHeight/2)
+	r.total(fontRegular, "Subtotal", inv.Subtotal)
+	r.total(fontRegular, fmt.Sprintf("Tax (%.2f%%)", inv.TaxRate*100), inv.TaxAmount)
+	r.total(fontBold, "Total", inv.Total)
+	if inv.RefundedAmount > 0 {
+		r.total(fontRegular, "Refunded", -inv.RefundedAmount)
+		r.total(fontBold, "Amount paid", inv.Total-inv.RefundedAmount)
+	}
+	r.y -= lineHeight
+	r.write(fontRegular, 8, marginLeft, "Prices include tax.")
+
+	return r.doc.bytes()
+}
+
+type renderer struct {
+	doc  *pdfDocument
+	inv  *domain.Invoice
+	page int
+	y    float64
+}
+
+func (r *renderer) newPage() {
+	r.doc.addPage()
+	r.page++
+	r.y = marginTop
+	if r.page > 1 {
+		r.write(fontRegular, 9, marginLeft, fmt.Sprintf("%s (continued, page %d)", r.inv.Number, r.page))
+		r.y -= lineHeight
+	}
+}
+
+// write draws one line of text at the cursor and moves the cursor down.
+func (r *renderer) write(font string, size float64, x float64, s string) {
+	if s == "" {
+		return
+	}
+	r.doc.text(x, r.y, font, size, s)
+	r.y -= max(size+4, lineHeight)
+}
+
+func (r *renderer) field(label, value string) {
+	r.doc.text(colFieldLabel, r.y, fontBold, 9, label)
+	r.doc.text(colFieldValue, r.y, fontRegular, 9, value)
+	r.y -= lineHeight
+}
+
+func (r *renderer) tableHeader() {
+	r.doc.text(colProduct, r.y, fontBold, 10, "Product")
+	r.doc.text(colQuantity, r.y, fontBold, 10, "Qty")
+	r.doc.text(colUnit, r.y, fontBold, 10, "Unit price")
+	r.doc.text(colTotal, r.y, fontBold, 10, "Amount")
+	r.doc.line(marginLeft, r.y-5, marginRight, r.y-5)
+	r.y -= lineHeight + 4
+}
+
+func (r *renderer) total(font, label string, amount float64) {
+	r.doc.text(colUnit, r.y, font, 10, label)
+	r.doc.text(colTotal, r.y, font, 10, formatAmount(amount))
+	r.y -= lineHeight
+}
+
+func formatAmount(v float64) string {
+	return fmt.Sprintf("%.2f", v)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-3]) + "..."
+}
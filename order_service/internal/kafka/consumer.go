@@ -2,48 +2,219 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	orderpb "order_service/proto/gen"
+	"strconv"
+	"time"
 
-	"github.com/redis/go-redis/v9"
 	"github.com/segmentio/kafka-go"
 	"google.golang.org/protobuf/proto"
 )
 
 type consumer struct {
 	reader      *kafka.Reader
-	redisClient *redis.Client
+	deadLetters *kafka.Writer
+	policy      RetryPolicy
+	events      EventLog
 }
 
+// EventLog records the event ids a consumer group has processed.
+type EventLog interface {
+	Seen(ctx context.Context, consumerGroup, eventID string) (bool, error)
+	MarkProcessed(ctx context.Context, consumerGroup, eventID string) error
+	DeleteProcessedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// ResultHandler persists a validation result. A non-nil error means the
+// message must be retried and its offset must not be committed.
+type ResultHandler func(ctx context.Context, result *orderpb.OrderValidationResultEvent) error
+
 type Consumer interface {
-	StartResultListener(ctx context.Context)
+	StartResultListener(ctx context.Context, handle ResultHandler)
+	// RunEventLogSweeper forgets processed events older than retention every
+	// interval until ctx is cancelled.
+	RunEventLogSweeper(ctx context.Context, interval, retention time.Duration)
 }
 
-func NewConsumer(reader *kafka.Reader) (Consumer, func() error) {
-	c := &consumer{reader: reader}
-	return c, c.reader.Close
+// NewConsumer returns a consumer that retries each message according to
+// policy and then moves it to the deadLetters writer's topic. Events already
+// recorded in events for the reader's group are skipped.
+func NewConsumer(reader *kafka.Reader, deadLetters *kafka.Writer, policy RetryPolicy, events EventLog) (Consumer, func() error) {
+	c := &consumer{
+		reader:      reader,
+		deadLetters: deadLetters,
+		policy:      policy,
+		events:      events,
+	}
+	return c, func() error {
+		return errors.Join(c.reader.Close(), c.deadLetters.Close())
+	}
 }
 
-func (c *consumer) StartResultListener(ctx context.Context) {
+// StartResultListener blocks until ctx is cancelled. Offsets are committed
+// only after handle succeeded or the message was dead-lettered, so a crash
+// mid-write redelivers the message.
+func (c *consumer) StartResultListener(ctx context.Context, handle ResultHandler) {
+	fetchFailures := 0
 	for {
-		m, err := c.reader.ReadMessage(ctx)
+		m, err := c.reader.FetchMessage(ctx)
 		if err != nil {
-			log.Printf("Error reading message: %v", err)
+			if ctx.Err() != nil {
+				return
+			}
+			fetchFailures++
+			backoff := c.policy.Backoff(fetchFailures)
+			log.Printf("Error fetching message, retrying in %s: %v", backoff, err)
+			if !sleep(ctx, backoff) {
+				return
+			}
 			continue
 		}
-		var result orderpb.OrderValidationResultEvent
-		if err := proto.Unmarshal(m.Value, &result); err != nil {
-			log.Printf("Failed to unmarshal: %v", err)
-			continue
+		fetchFailures = 0
+
+		attempts, err := c.processResult(ctx, m, handle)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && !c.deadLetter(ctx, m, err, attempts) {
+			return
 		}
-		status := "confirmed"
-		if !result.IsValid {
-			status = "Rejected"
+
+		if err := c.reader.CommitMessages(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Failed to commit offset %d: %v", m.Offset, err)
+		}
+	}
+}
+
+func (c *consumer) RunEventLogSweeper(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := c.events.DeleteProcessedBefore(ctx, time.Now().UTC().Add(-retention))
+			if err != nil {
+				log.Printf("Failed to delete processed events: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("Deleted %d processed events", n)
+			}
 		}
-		msg := fmt.Sprintf("%s:%s", result.OrderId, status)
-		c.redisClient.Publish(ctx, "order_updated", msg)
-		log.Printf("Order %s processed. Status: %s", result.OrderId, status)
 	}
+}
 
+// processResult handles m, retrying failures with exponential backoff. It
+// returns the number of attempts made and the last error once they ran out.
+// Malformed messages and unknown schema versions fail on every attempt, so
+// they are not retried.
+func (c *consumer) processResult(ctx context.Context, m kafka.Message, handle ResultHandler) (int, error) {
+	envelope, err := Open(m)
+	if err != nil {
+		return 1, err
+	}
+	if envelope.EventType != "" && envelope.EventType != EventTypeOrderValidationResult {
+		return 1, fmt.Errorf("unexpected event type %q", envelope.EventType)
+	}
+	if err := checkSchema(envelope); err != nil {
+		return 1, err
+	}
+	var result orderpb.OrderValidationResultEvent
+	if err := proto.Unmarshal(envelope.Payload, &result); err != nil {
+		return 1, fmt.Errorf("failed to unmarshal validation result: %w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := c.handleOnce(ctx, envelope.EventId, func(ctx context.Context) error {
+			return handle(ctx, &result)
+		})
+		if err == nil {
+			return attempt, nil
+		}
+		if attempt >= c.policy.MaxAttempts {
+			return attempt, err
+		}
+		backoff := c.policy.Backoff(attempt)
+		log.Printf("Failed to handle result for order %s (attempt %d/%d), retrying in %s: %v",
+			result.OrderId, attempt, c.policy.MaxAttempts, backoff, err)
+		if !sleep(ctx, backoff) {
+			return attempt, ctx.Err()
+		}
+	}
+}
+
+// handleOnce runs handle unless the event was already processed by this
+// consumer group, and records it afterwards. Events without an id predate
+// the envelope and are always handled.
+func (c *consumer) handleOnce(ctx context.Context, eventID string, handle func(ctx context.Context) error) error {
+	group := c.reader.Config().GroupID
+	if eventID != "" {
+		seen, err := c.events.Seen(ctx, group, eventID)
+		if err != nil {
+			return err
+		}
+		if seen {
+			log.Printf("Skipping already processed event %s", eventID)
+			return nil
+		}
+	}
+	if err := handle(ctx); err != nil {
+		return err
+	}
+	if eventID == "" {
+		return nil
+	}
+	return c.events.MarkProcessed(ctx, group, eventID)
+}
+
+// deadLetter publishes m with its failure to the dead-letter topic, retrying
+// until it is accepted. It reports false if ctx was cancelled first.
+func (c *consumer) deadLetter(ctx context.Context, m kafka.Message, cause error, attempts int) bool {
+	msg := kafka.Message{
+		Key:   m.Key,
+		Value: m.Value,
+		Headers: append(append([]kafka.Header{}, m.Headers...),
+			kafka.Header{Key: DeadLetterErrorHeader, Value: []byte(cause.Error())},
+			kafka.Header{Key: DeadLetterAttemptsHeader, Value: []byte(strconv.Itoa(attempts))},
+			kafka.Header{Key: DeadLetterFailedAtHeader, Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+			kafka.Header{Key: DeadLetterOriginalTopicHeader, Value: []byte(m.Topic)},
+			kafka.Header{Key: DeadLetterOriginalPartitionHeader, Value: []byte(strconv.Itoa(m.Partition))},
+			kafka.Header{Key: DeadLetterOriginalOffsetHeader, Value: []byte(strconv.FormatInt(m.Offset, 10))},
+		),
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := c.deadLetters.WriteMessages(ctx, msg)
+		if err == nil {
+			log.Printf("Dead-lettered message at offset %d after %d attempts: %v", m.Offset, attempts, cause)
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		backoff := c.policy.Backoff(attempt)
+		log.Printf("Failed to dead-letter message at offset %d, retrying in %s: %v", m.Offset, backoff, err)
+		if !sleep(ctx, backoff) {
+			return false
+		}
+	}
+}
+
+// sleep waits for d and reports false if ctx was cancelled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
 }
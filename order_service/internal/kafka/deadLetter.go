@@ -0,0 +1,234 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is a message read back from a dead-letter topic with its
+// failure headers decoded. Headers keeps only the original message headers.
+type DeadLetter struct {
+	Partition         int
+	Offset            int64
+	Key               string
+	EventType         string
+	OriginalTopic     string
+	OriginalPartition int
+	OriginalOffset    int64
+	Error             string
+	Attempts          int
+	Payload           []byte
+	Headers           []kafka.Header
+	FailedAt          time.Time
+}
+
+// PartitionOpener returns a group-less reader for one partition of topic.
+type PartitionOpener func(topic string, partition int) *kafka.Reader
+
+type deadLetterStore struct {
+	topic         string
+	client        *kafka.Client
+	openPartition PartitionOpener
+	replay        *kafka.Writer
+}
+
+type DeadLetterStore interface {
+	// List returns up to limit of the most recent dead letters, newest first.
+	List(ctx context.Context, limit int) ([]*DeadLetter, error)
+	Get(ctx context.Context, partition int, offset int64) (*DeadLetter, error)
+	// Replay republishes the original message to the replay writer's topic.
+	Replay(ctx context.Context, letter *DeadLetter) error
+	// ReplayTopic is the topic Replay writes to.
+	ReplayTopic() string
+}
+
+// NewDeadLetterStore reads dead letters from topic and replays them through
+// replay, which must write to the topic they were consumed from.
+func NewDeadLetterStore(client *kafka.Client, openPartition PartitionOpener, topic string, replay *kafka.Writer) (DeadLetterStore, func() error) {
+	s := &deadLetterStore{
+		topic:         topic,
+		client:        client,
+		openPartition: openPartition,
+		replay:        replay,
+	}
+	return s, s.replay.Close
+}
+
+func (s *deadLetterStore) ReplayTopic() string {
+	return s.replay.Topic
+}
+
+func (s *deadLetterStore) List(ctx context.Context, limit int) ([]*DeadLetter, error) {
+	offsets, err := s.partitionOffsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	letters := []*DeadLetter{}
+	for _, p := range offsets {
+		from := max(p.FirstOffset, p.LastOffset-int64(limit))
+		batch, err := s.read(ctx, p.Partition, from, p.LastOffset)
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, batch...)
+	}
+
+	sort.Slice(letters, func(i, j int) bool {
+		return letters[i].FailedAt.After(letters[j].FailedAt)
+	})
+	if len(letters) > limit {
+		letters = letters[:limit]
+	}
+	return letters, nil
+}
+
+func (s *deadLetterStore) Get(ctx context.Context, partition int, offset int64) (*DeadLetter, error) {
+	offsets, err := s.partitionOffsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range offsets {
+		if p.Partition != partition {
+			continue
+		}
+		if offset < p.FirstOffset || offset >= p.LastOffset {
+			return nil, ErrDeadLetterNotFound
+		}
+		letters, err := s.read(ctx, partition, offset, offset+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(letters) == 0 || letters[0].Offset != offset {
+			// The offset was compacted away or held a control record.
+			return nil, ErrDeadLetterNotFound
+		}
+		return letters[0], nil
+	}
+	return nil, ErrDeadLetterNotFound
+}
+
+func (s *deadLetterStore) Replay(ctx context.Context, letter *DeadLetter) error {
+	headers := append([]kafka.Header{}, letter.Headers...)
+	headers = append(headers, kafka.Header{
+		Key:   ReplayedFromHeader,
+		Value: []byte(fmt.Sprintf("%d/%d", letter.Partition, letter.Offset)),
+	})
+	err := s.replay.WriteMessages(ctx, kafka.Message{
+		Key:     []byte(letter.Key),
+		Value:   letter.Payload,
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replay dead letter: %w", err)
+	}
+	return nil
+}
+
+// partitionOffsets returns the readable offset range of every partition of
+// the topic. A topic that was never written to has no partitions.
+func (s *deadLetterStore) partitionOffsets(ctx context.Context) ([]kafka.PartitionOffsets, error) {
+	meta, err := s.client.Metadata(ctx, &kafka.MetadataRequest{Topics: []string{s.topic}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dead-letter topic metadata: %w", err)
+	}
+	if len(meta.Topics) == 0 || errors.Is(meta.Topics[0].Error, kafka.UnknownTopicOrPartition) {
+		return nil, nil
+	}
+	if meta.Topics[0].Error != nil {
+		return nil, fmt.Errorf("failed to read dead-letter topic metadata: %w", meta.Topics[0].Error)
+	}
+
+	requests := make([]kafka.OffsetRequest, 0, 2*len(meta.Topics[0].Partitions))
+	for _, p := range meta.Topics[0].Partitions {
+		requests = append(requests, kafka.FirstOffsetOf(p.ID), kafka.LastOffsetOf(p.ID))
+	}
+	resp, err := s.client.ListOffsets(ctx, &kafka.ListOffsetsRequest{
+		Topics: map[string][]kafka.OffsetRequest{s.topic: requests},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead-letter offsets: %w", err)
+	}
+	offsets := resp.Topics[s.topic]
+	for _, p := range offsets {
+		if p.Error != nil {
+			return nil, fmt.Errorf("failed to list offsets of partition %d: %w", p.Partition, p.Error)
+		}
+	}
+	return offsets, nil
+}
+
+// read returns the dead letters of one partition in [from, to).
+func (s *deadLetterStore) read(ctx context.Context, partition int, from, to int64) ([]*DeadLetter, error) {
+	if from >= to {
+		return nil, nil
+	}
+	reader := s.openPartition(s.topic, partition)
+	defer reader.Close()
+	if err := reader.SetOffset(from); err != nil {
+		return nil, fmt.Errorf("failed to seek dead-letter partition %d: %w", partition, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	letters := make([]*DeadLetter, 0, to-from)
+	for {
+		m, err := reader.ReadMessage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dead-letter partition %d: %w", partition, err)
+		}
+		if m.Offset >= to {
+			return letters, nil
+		}
+		letters = append(letters, decodeDeadLetter(m))
+		if m.Offset == to-1 {
+			return letters, nil
+		}
+	}
+}
+
+func decodeDeadLetter(m kafka.Message) *DeadLetter {
+	letter := &DeadLetter{
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Key:       string(m.Key),
+		Payload:   m.Value,
+		FailedAt:  m.Time,
+	}
+	for _, h := range m.Headers {
+		value := string(h.Value)
+		switch h.Key {
+		case DeadLetterErrorHeader:
+			letter.Error = value
+		case DeadLetterAttemptsHeader:
+			letter.Attempts, _ = strconv.Atoi(value)
+		case DeadLetterFailedAtHeader:
+			if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+				letter.FailedAt = t
+			}
+		case DeadLetterOriginalTopicHeader:
+			letter.OriginalTopic = value
+		case DeadLetterOriginalPartitionHeader:
+			letter.OriginalPartition, _ = strconv.Atoi(value)
+		case DeadLetterOriginalOffsetHeader:
+			letter.OriginalOffset, _ = strconv.ParseInt(value, 10, 64)
+		default:
+			if !strings.HasPrefix(h.Key, "dlq-") {
+				letter.Headers = append(letter.Headers, h)
+			}
+			if h.Key == EventTypeHeader {
+				letter.EventType = value
+			}
+		}
+	}
+	return letter
+}
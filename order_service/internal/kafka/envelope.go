@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	orderpb "order_service/proto/gen"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// SchemaVersion is the "major.minor" version of the events this service
+	// publishes. Minor bumps only add fields; a new major is incompatible.
+	SchemaVersion = "1.0"
+	// EnvelopeVersion is the envelope format Seal produces and Open accepts.
+	// It is recorded in the envelope and sent in the EnvelopeHeader.
+	EnvelopeVersion = "1"
+	// ProducerService names this service in the envelopes it publishes.
+	ProducerService = "order_service"
+)
+
+// ErrUnsupportedEnvelope and ErrUnsupportedSchema mean an envelope format or
+// a payload major version is unknown, so the message can never be decoded by
+// this build and must not be retried.
+var (
+	ErrUnsupportedEnvelope = errors.New("unsupported event envelope version")
+	ErrUnsupportedSchema   = errors.New("unsupported event schema version")
+)
+
+// Seal wraps event in a new envelope and returns it serialized.
+func Seal(eventType, correlationID string, event proto.Message, occurredAt time.Time) ([]byte, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+	envelope, err := proto.Marshal(&orderpb.EventEnvelope{
+		EventId:         uuid.NewString(),
+		EventType:       eventType,
+		SchemaVersion:   SchemaVersion,
+		OccurredAt:      timestamppb.New(occurredAt),
+		CorrelationId:   correlationID,
+		Producer:        ProducerService,
+		Payload:         payload,
+		EnvelopeVersion: EnvelopeVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s envelope: %w", eventType, err)
+	}
+	return envelope, nil
+}
+
+// Open decodes the envelope of m and checks its format. The payload's schema
+// version is left to its decoder, see checkSchema. Messages published before
+// envelopes existed are wrapped as is, without an event id.
+func Open(m kafka.Message) (*orderpb.EventEnvelope, error) {
+	if header(m, EnvelopeHeader) == "" {
+		return &orderpb.EventEnvelope{
+			EventType:     header(m, EventTypeHeader),
+			SchemaVersion: SchemaVersion,
+			Payload:       m.Value,
+		}, nil
+	}
+
+	var envelope orderpb.EventEnvelope
+	if err := proto.Unmarshal(m.Value, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event envelope: %w", err)
+	}
+	version := envelope.EnvelopeVersion
+	if version == "" {
+		// Envelopes sealed before the field existed name their format in
+		// the header only.
+		version = header(m, EnvelopeHeader)
+	}
+	if version != EnvelopeVersion {
+		return nil, fmt.Errorf("%w %q for %s event %s", ErrUnsupportedEnvelope, version, envelope.EventType, envelope.EventId)
+	}
+	return &envelope, nil
+}
+
+// checkSchema fails with ErrUnsupportedSchema unless the payload has the
+// major version of SchemaVersion. Minor versions only add fields, which older
+// decoders skip.
+func checkSchema(envelope *orderpb.EventEnvelope) error {
+	major, _, _ := strings.Cut(envelope.SchemaVersion, ".")
+	if supported, _, _ := strings.Cut(SchemaVersion, "."); major != supported {
+		return fmt.Errorf("%w %q for %s event %s", ErrUnsupportedSchema, envelope.SchemaVersion, envelope.EventType, envelope.EventId)
+	}
+	return nil
+}
+
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -2,10 +2,6 @@ package kafka
 
 import (
 	"context"
-	"fmt"
-	orderpb "order_service/proto/gen"
-
-	"google.golang.org/protobuf/proto"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -15,7 +11,7 @@ type producer struct {
 }
 
 type Producer interface {
-	PublishOrderCreated(ctx context.Context, event *orderpb.OrderCreatedEvent) error
+	Publish(ctx context.Context, eventType, key, envelope string, value []byte) error
 }
 
 func NewProducer(writer *kafka.Writer) (Producer, func() error) {
@@ -23,13 +19,17 @@ func NewProducer(writer *kafka.Writer) (Producer, func() error) {
 	return p, p.writer.Close
 }
 
-func (p *producer) PublishOrderCreated(ctx context.Context, event *orderpb.OrderCreatedEvent) error {
-	value, err := proto.Marshal(event)
-	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
+// Publish sends value, sealed with the given envelope version. An empty
+// envelope sends a bare payload without the EnvelopeHeader, which is how
+// events were published before Seal existed.
+func (p *producer) Publish(ctx context.Context, eventType, key, envelope string, value []byte) error {
+	headers := []kafka.Header{{Key: EventTypeHeader, Value: []byte(eventType)}}
+	if envelope != "" {
+		headers = append(headers, kafka.Header{Key: EnvelopeHeader, Value: []byte(envelope)})
 	}
 	return p.writer.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(event.OrderId),
-		Value: value,
+		Key:     []byte(key),
+		Value:   value,
+		Headers: headers,
 	})
 }
@@ -0,0 +1,21 @@
+package kafka
+
+import "time"
+
+// RetryPolicy bounds how often a consumer retries a message and how long it
+// waits between attempts.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns the wait after the given failed attempt (1-based), doubling
+// from InitialBackoff up to MaxBackoff.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxBackoff)
+}
@@ -1,6 +1,27 @@
 package kafka
 
+// EventTypeHeader carries the outbox event type so consumers of a topic that
+// holds several event types can tell them apart. EnvelopeHeader is set to the
+// envelope format version on messages whose value is an EventEnvelope.
 const (
-	OrderEventsTopic  = "order-events"
-	OrderResultsTopic = "order-results"
+	EventTypeHeader = "event-type"
+	EnvelopeHeader  = "envelope"
+)
+
+// EventTypeOrderValidationResult is the event product_service publishes on
+// the order results topic.
+const EventTypeOrderValidationResult = "OrderValidationResult"
+
+// Dead-letter headers are added next to the original message's headers to
+// record where it came from and why it failed.
+const (
+	DeadLetterErrorHeader             = "dlq-error"
+	DeadLetterAttemptsHeader          = "dlq-attempts"
+	DeadLetterFailedAtHeader          = "dlq-failed-at"
+	DeadLetterOriginalTopicHeader     = "dlq-original-topic"
+	DeadLetterOriginalPartitionHeader = "dlq-original-partition"
+	DeadLetterOriginalOffsetHeader    = "dlq-original-offset"
+	// ReplayedFromHeader marks a message replayed from the dead-letter topic
+	// with its "partition/offset" there.
+	ReplayedFromHeader = "dlq-replayed-from"
 )
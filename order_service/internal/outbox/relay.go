@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"order_service/internal/domain"
+	"order_service/internal/kafka"
+	outboxRepo "order_service/internal/repo/outbox"
+	"sort"
+	"time"
+)
+
+const (
+	pollInterval = time.Second
+	batchSize    = 100
+	claimLease   = 30 * time.Second
+	baseBackoff  = time.Second
+	maxBackoff   = 5 * time.Minute
+)
+
+type Relay struct {
+	repo     outboxRepo.Repo
+	producer kafka.Producer
+}
+
+func NewRelay(repo outboxRepo.Repo, producer kafka.Producer) *Relay {
+	return &Relay{
+		repo:     repo,
+		producer: producer,
+	}
+}
+
+// Run publishes staged outbox messages until ctx is cancelled. Delivery is
+// at-least-once: a message is marked sent only after Kafka acknowledged it.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		r.flush(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) flush(ctx context.Context) {
+	for ctx.Err() == nil {
+		messages, err := r.repo.Claim(ctx, batchSize, claimLease)
+		if err != nil {
+			log.Printf("outbox: %v", err)
+			return
+		}
+		if len(messages) == 0 {
+			return
+		}
+		// RETURNING does not preserve the subquery order.
+		sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+		// Once a message fails, later messages with its key stay unpublished
+		// so they cannot overtake it. They keep their lease, and Claim holds
+		// them back until the failed message is sent.
+		failedKeys := map[string]bool{}
+		for _, msg := range messages {
+			if failedKeys[msg.EventKey] {
+				continue
+			}
+			if !r.publish(ctx, msg) {
+				failedKeys[msg.EventKey] = true
+			}
+		}
+		if len(messages) < batchSize {
+			return
+		}
+	}
+}
+
+// publish sends msg and reports whether Kafka acknowledged it.
+func (r *Relay) publish(ctx context.Context, msg domain.OutboxMessage) bool {
+	if err := r.producer.Publish(ctx, msg.EventType, msg.EventKey, msg.Envelope, msg.Payload); err != nil {
+		next := time.Now().UTC().Add(backoff(msg.Attempts))
+		log.Printf("outbox: failed to publish message %d (%s), retrying at %s: %v", msg.ID, msg.EventType, next.Format(time.RFC3339), err)
+		if err := r.repo.MarkFailed(ctx, msg.ID, err.Error(), next); err != nil {
+			log.Printf("outbox: %v", err)
+		}
+		return false
+	}
+	if err := r.repo.MarkSent(ctx, msg.ID); err != nil {
+		log.Printf("outbox: %v", err)
+	}
+	return true
+}
+
+func backoff(attempts int) time.Duration {
+	d := baseBackoff
+	for i := 0; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d
+}
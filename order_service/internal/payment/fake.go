@@ -0,0 +1,199 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FakeDeclinedMethod is the payment method the fake provider always declines.
+const FakeDeclinedMethod = "fake_declined"
+
+const (
+	fakeWebhookDelay    = time.Second
+	fakeWebhookAttempts = 5
+)
+
+// WebhookSink receives provider callbacks, as the webhook endpoint would.
+type WebhookSink func(ctx context.Context, payload []byte, signature string) error
+
+type fakeAuthorization struct {
+	amount   float64
+	captured float64
+	refunded float64
+	voided   bool
+}
+
+// FakeProvider is an in-process PaymentProvider for local development. It
+// approves every payment method except FakeDeclinedMethod and, like a real
+// provider, reports the outcome of each authorization through a signed
+// webhook delivered to the sink.
+type FakeProvider struct {
+	secret []byte
+	sink   WebhookSink
+
+	mu             sync.Mutex
+	authorizations map[string]*fakeAuthorization
+	captures       map[string]string  // capture id -> authorization id
+	refunds        map[string]*Refund // idempotency key -> refund
+}
+
+func NewFakeProvider(webhookSecret string) *FakeProvider {
+	return &FakeProvider{
+		secret:         []byte(webhookSecret),
+		authorizations: make(map[string]*fakeAuthorization),
+		captures:       make(map[string]string),
+		refunds:        make(map[string]*Refund),
+	}
+}
+
+// SetWebhookSink sets where callbacks are delivered. Without a sink they are
+// dropped.
+func (p *FakeProvider) SetWebhookSink(sink WebhookSink) {
+	p.sink = sink
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Authorize(ctx context.Context, req *AuthorizeRequest) (*Authorization, error) {
+	auth := &Authorization{ID: "fake_auth_" + uuid.New().String()}
+	event := &WebhookEvent{ID: "fake_evt_" + uuid.New().String(), AuthorizationID: auth.ID}
+
+	if req.PaymentMethod == FakeDeclinedMethod {
+		auth.Status = AuthorizationDeclined
+		auth.DeclineReason = "card declined"
+		event.Type = WebhookAuthorizationFailed
+		event.FailureReason = auth.DeclineReason
+	} else {
+		p.mu.Lock()
+		p.authorizations[auth.ID] = &fakeAuthorization{amount: req.Amount}
+		p.mu.Unlock()
+		auth.Status = AuthorizationApproved
+		event.Type = WebhookAuthorizationSucceeded
+	}
+
+	go p.deliver(event)
+	return auth, nil
+}
+
+func (p *FakeProvider) Capture(ctx context.Context, authorizationID string, amount float64) (*Capture, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	auth, ok := p.authorizations[authorizationID]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: unknown authorization %s", authorizationID)
+	}
+	if auth.voided {
+		return nil, fmt.Errorf("fake provider: authorization %s was voided", authorizationID)
+	}
+	if auth.captured+amount > auth.amount {
+		return nil, fmt.Errorf("fake provider: capture of %.2f exceeds authorized %.2f", amount, auth.amount-auth.captured)
+	}
+	auth.captured += amount
+
+	id := "fake_cap_" + uuid.New().String()
+	p.captures[id] = authorizationID
+	return &Capture{ID: id, Amount: amount}, nil
+}
+
+func (p *FakeProvider) Void(ctx context.Context, authorizationID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	auth, ok := p.authorizations[authorizationID]
+	if !ok {
+		return fmt.Errorf("fake provider: unknown authorization %s", authorizationID)
+	}
+	if auth.captured > 0 {
+		return fmt.Errorf("fake provider: authorization %s was already captured", authorizationID)
+	}
+	auth.voided = true
+	return nil
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, captureID string, amount float64, idempotencyKey string) (*Refund, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if refund, ok := p.refunds[idempotencyKey]; ok {
+		return refund, nil
+	}
+
+	authID, ok := p.captures[captureID]
+	if !ok {
+		return nil, fmt.Errorf("fake provider: unknown capture %s: %w", captureID, ErrRefundRejected)
+	}
+	auth := p.authorizations[authID]
+	if auth.refunded+amount > auth.captured {
+		return nil, fmt.Errorf("fake provider: refund of %.2f exceeds refundable %.2f: %w", amount, auth.captured-auth.refunded, ErrRefundRejected)
+	}
+	auth.refunded += amount
+	refund := &Refund{ID: "fake_ref_" + uuid.New().String(), Amount: amount}
+	p.refunds[idempotencyKey] = refund
+	return refund, nil
+}
+
+func (p *FakeProvider) VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.sign(payload)) {
+		return nil, ErrInvalidSignature
+	}
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook: %w", err)
+	}
+	return &event, nil
+}
+
+// SignWebhook encodes and signs event the way the fake's callbacks are, so
+// callbacks can also be sent by hand to the webhook endpoint.
+func (p *FakeProvider) SignWebhook(event *WebhookEvent) ([]byte, string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, hex.EncodeToString(p.sign(payload)), nil
+}
+
+func (p *FakeProvider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// deliver sends the callback with retries, as providers do until the
+// webhook endpoint acknowledges it.
+func (p *FakeProvider) deliver(event *WebhookEvent) {
+	if p.sink == nil {
+		return
+	}
+	payload, signature, err := p.SignWebhook(event)
+	if err != nil {
+		log.Printf("fake provider: failed to sign webhook %s: %v", event.ID, err)
+		return
+	}
+
+	delay := fakeWebhookDelay
+	for attempt := 1; attempt <= fakeWebhookAttempts; attempt++ {
+		time.Sleep(delay)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := p.sink(ctx, payload, signature)
+		cancel()
+		if err == nil {
+			return
+		}
+		log.Printf("fake provider: webhook %s attempt %d failed: %v", event.ID, attempt, err)
+		delay *= 2
+	}
+}
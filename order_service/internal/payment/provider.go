@@ -0,0 +1,72 @@
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	AuthorizationApproved = "approved"
+	AuthorizationDeclined = "declined"
+)
+
+// Webhook event types reported by providers once an authorization settles.
+const (
+	WebhookAuthorizationSucceeded = "authorization.succeeded"
+	WebhookAuthorizationFailed    = "authorization.failed"
+)
+
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// ErrRefundRejected is wrapped by Refund errors when the provider turned the
+// refund down and gave nothing back. Any other error leaves it open whether
+// the refund went through.
+var ErrRefundRejected = errors.New("refund rejected by provider")
+
+// PaymentProvider is the boundary to an external payment processor. Amounts
+// are in the currency's major unit.
+type PaymentProvider interface {
+	Name() string
+	Authorize(ctx context.Context, req *AuthorizeRequest) (*Authorization, error)
+	Capture(ctx context.Context, authorizationID string, amount float64) (*Capture, error)
+	// Void releases an authorization that was not captured.
+	Void(ctx context.Context, authorizationID string) error
+	// Refund gives back part of a capture. Repeating a refund with the same
+	// idempotency key returns the first refund instead of refunding again.
+	Refund(ctx context.Context, captureID string, amount float64, idempotencyKey string) (*Refund, error)
+	// VerifyWebhook checks the signature of a provider callback and decodes
+	// it. It returns ErrInvalidSignature for callbacks not sent by the
+	// provider.
+	VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error)
+}
+
+type AuthorizeRequest struct {
+	PaymentID     string
+	OrderID       string
+	Amount        float64
+	Currency      string
+	PaymentMethod string
+}
+
+type Authorization struct {
+	ID            string
+	Status        string
+	DeclineReason string
+}
+
+type Capture struct {
+	ID     string
+	Amount float64
+}
+
+type Refund struct {
+	ID     string
+	Amount float64
+}
+
+type WebhookEvent struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	AuthorizationID string `json:"authorization_id"`
+	FailureReason   string `json:"failure_reason,omitempty"`
+}
@@ -0,0 +1,86 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"order_service/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// orderChannelPrefix is followed by the order id; every committed status
+// change of that order is published on the channel as JSON.
+const orderChannelPrefix = "order_updated:"
+
+type statusNotifier struct {
+	rdb *redis.Client
+}
+
+type StatusNotifier interface {
+	// Publish announces change to the order's watchers. Pub/sub delivery is
+	// best effort: failures are logged and subscribers are expected to
+	// catch up from the status history.
+	Publish(ctx context.Context, change *domain.StatusChange)
+	// Subscribe listens to the order's status changes. The subscription is
+	// active once Subscribe returns, so nothing published afterwards is
+	// missed while the connection holds.
+	Subscribe(ctx context.Context, orderID string) (*Subscription, error)
+}
+
+func NewStatusNotifier(rdb *redis.Client) StatusNotifier {
+	return &statusNotifier{rdb: rdb}
+}
+
+func (n *statusNotifier) Publish(ctx context.Context, change *domain.StatusChange) {
+	payload, err := json.Marshal(change)
+	if err != nil {
+		log.Printf("pubsub: failed to encode status change of order %s: %v", change.OrderID, err)
+		return
+	}
+	if err := n.rdb.Publish(ctx, orderChannelPrefix+change.OrderID, payload).Err(); err != nil {
+		log.Printf("pubsub: failed to publish status change of order %s: %v", change.OrderID, err)
+	}
+}
+
+func (n *statusNotifier) Subscribe(ctx context.Context, orderID string) (*Subscription, error) {
+	ps := n.rdb.Subscribe(ctx, orderChannelPrefix+orderID)
+	if _, err := ps.Receive(ctx); err != nil {
+		ps.Close()
+		return nil, fmt.Errorf("failed to subscribe to order %s: %w", orderID, err)
+	}
+
+	changes := make(chan *domain.StatusChange)
+	go func() {
+		defer close(changes)
+		for msg := range ps.Channel() {
+			var change domain.StatusChange
+			if err := json.Unmarshal([]byte(msg.Payload), &change); err != nil {
+				log.Printf("pubsub: dropping malformed status change on %s: %v", msg.Channel, err)
+				continue
+			}
+			select {
+			case changes <- &change:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return &Subscription{ps: ps, changes: changes}, nil
+}
+
+// Subscription delivers the status changes of one order until closed.
+type Subscription struct {
+	ps      *redis.PubSub
+	changes chan *domain.StatusChange
+}
+
+// Changes is closed when the subscription is closed.
+func (s *Subscription) Changes() <-chan *domain.StatusChange {
+	return s.changes
+}
+
+func (s *Subscription) Close() error {
+	return s.ps.Close()
+}
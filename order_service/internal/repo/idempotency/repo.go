@@ -0,0 +1,84 @@
+package idempotencyRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"order_service/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrKeyNotFound = errors.New("idempotency key not found")
+	// ErrKeyInUse means another request stored a response for the key first.
+	ErrKeyInUse = errors.New("idempotency key already used")
+)
+
+type repo struct {
+	db *sqlx.DB
+}
+
+type Repo interface {
+	// Get returns the unexpired response stored for the key.
+	Get(ctx context.Context, userID, operation, key string) (*domain.IdempotencyKey, error)
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+func NewRepo(db *sqlx.DB) Repo {
+
+	return &repo{
+		db: db,
+	}
+}
+
+// Insert stores the key inside the caller's transaction, taking over an
+// expired entry. A concurrent insert of the same key blocks on the primary
+// key until this transaction ends, so only one of them can commit; the other
+// gets ErrKeyInUse.
+func Insert(ctx context.Context, tx *sqlx.Tx, key *domain.IdempotencyKey) error {
+	query := `
+		INSERT INTO idempotency_keys (user_id, operation, idempotency_key, request_hash, response, created_at, expires_at)
+		VALUES (:user_id, :operation, :idempotency_key, :request_hash, :response, :created_at, :expires_at)
+		ON CONFLICT (user_id, operation, idempotency_key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, response = EXCLUDED.response,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`
+	result, err := tx.NamedExecContext(ctx, query, key)
+	if err != nil {
+		return fmt.Errorf("failed to insert idempotency key: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to insert idempotency key: %w", err)
+	}
+	if n == 0 {
+		return ErrKeyInUse
+	}
+	return nil
+}
+
+func (r *repo) Get(ctx context.Context, userID, operation, key string) (*domain.IdempotencyKey, error) {
+	var record domain.IdempotencyKey
+	query := `
+		SELECT user_id, operation, idempotency_key, request_hash, response, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND operation = $2 AND idempotency_key = $3 AND expires_at > NOW()`
+	if err := r.db.GetContext(ctx, &record, query, userID, operation, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	return &record, nil
+}
+
+// DeleteExpired removes keys whose replay window has passed.
+func (r *repo) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,94 @@
+package invoiceRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"order_service/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	// ErrInvoiceExists is returned by Create when the order was invoiced
+	// concurrently; the allocated number is released again.
+	ErrInvoiceExists = errors.New("order already invoiced")
+)
+
+type repo struct {
+	db *sqlx.DB
+}
+
+type Repo interface {
+	GetByOrderID(ctx context.Context, orderID string) (*domain.Invoice, error)
+	// Create allocates the next number of the invoice's issue year, formatted
+	// as <prefix>-<year>-<number>, and stores the invoice in one transaction,
+	// so numbers are sequential without gaps.
+	Create(ctx context.Context, invoice *domain.Invoice, prefix string) error
+}
+
+const invoiceColumns = `id, order_id, invoice_number, buyer_name, buyer_email, buyer_phone, buyer_address,
+	currency, subtotal, tax_rate, tax_amount, total, issued_at`
+
+func NewRepo(db *sqlx.DB) Repo {
+
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) GetByOrderID(ctx context.Context, orderID string) (*domain.Invoice, error) {
+	var invoice domain.Invoice
+	err := r.db.GetContext(ctx, &invoice, `SELECT `+invoiceColumns+` FROM invoices WHERE order_id = $1`, orderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvoiceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+	return &invoice, nil
+}
+
+func (r *repo) Create(ctx context.Context, invoice *domain.Invoice, prefix string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	year := invoice.IssuedAt.Year()
+	var number int64
+	counterQuery := `
+		INSERT INTO invoice_counters (year, last_number) VALUES ($1, 1)
+		ON CONFLICT (year) DO UPDATE SET last_number = invoice_counters.last_number + 1
+		RETURNING last_number`
+	if err := tx.GetContext(ctx, &number, counterQuery, year); err != nil {
+		return fmt.Errorf("failed to allocate invoice number: %w", err)
+	}
+	invoice.Number = fmt.Sprintf("%s-%d-%06d", prefix, year, number)
+
+	insertQuery := `
+		INSERT INTO invoices (id, order_id, invoice_number, buyer_name, buyer_email, buyer_phone, buyer_address,
+			currency, subtotal, tax_rate, tax_amount, total, issued_at)
+		VALUES (:id, :order_id, :invoice_number, :buyer_name, :buyer_email, :buyer_phone, :buyer_address,
+			:currency, :subtotal, :tax_rate, :tax_amount, :total, :issued_at)
+		ON CONFLICT (order_id) DO NOTHING`
+	result, err := tx.NamedExecContext(ctx, insertQuery, invoice)
+	if err != nil {
+		return fmt.Errorf("failed to insert invoice: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to insert invoice: %w", err)
+	}
+	if inserted == 0 {
+		return ErrInvoiceExists
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit invoice: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package orderRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"order_service/internal/domain"
+	outboxRepo "order_service/internal/repo/outbox"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrRefundExceedsOrder = errors.New("refund exceeds what is left to refund on the order")
+
+// refundAmountTolerance absorbs NUMERIC(10,2) rounding of line amounts.
+const refundAmountTolerance = 0.005
+
+// CreateRefund records a pending refund and counts its quantities and amount
+// against the order straight away, so concurrent refunds can never give back
+// more than was charged.
+func (r *repo) CreateRefund(ctx context.Context, refund *domain.Refund) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var totals struct {
+		TotalAmount    float64 `db:"total_amount"`
+		RefundedAmount float64 `db:"refunded_amount"`
+	}
+	lockQuery := `SELECT total_amount, refunded_amount FROM orders WHERE id = $1 FOR UPDATE`
+	if err := tx.GetContext(ctx, &totals, lockQuery, refund.OrderID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("failed to lock order: %w", err)
+	}
+	if totals.RefundedAmount+refund.Amount > totals.TotalAmount+refundAmountTolerance {
+		return ErrRefundExceedsOrder
+	}
+
+	if err := adjustRefundedQuantities(ctx, tx, refund, 1); err != nil {
+		return err
+	}
+	if err := adjustRefundedAmount(ctx, tx, refund, 1); err != nil {
+		return err
+	}
+
+	refundQuery := `
+		INSERT INTO refunds (id, order_id, payment_id, provider_refund_id, amount, reason, actor, status, failure_reason, created_at, updated_at)
+		VALUES (:id, :order_id, :payment_id, NULLIF(:provider_refund_id, ''), :amount, :reason, :actor, :status,
+			NULLIF(:failure_reason, ''), :created_at, :updated_at)`
+	if _, err := tx.NamedExecContext(ctx, refundQuery, refund); err != nil {
+		return fmt.Errorf("failed to insert refund: %w", err)
+	}
+	itemQuery := `
+		INSERT INTO refund_items (refund_id, order_item_id, product_id, category, quantity, amount)
+		VALUES (:refund_id, :order_item_id, :product_id, :category, :quantity, :amount)`
+	for _, item := range refund.Items {
+		if _, err := tx.NamedExecContext(ctx, itemQuery, item); err != nil {
+			return fmt.Errorf("failed to insert refund item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit refund: %w", err)
+	}
+	return nil
+}
+
+// FailRefund marks a pending refund failed and releases what it had counted
+// against the order.
+func (r *repo) FailRefund(ctx context.Context, refund *domain.Refund) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	updated, err := resolveRefund(ctx, tx, refund)
+	if err != nil || !updated {
+		return err
+	}
+	if err := adjustRefundedQuantities(ctx, tx, refund, -1); err != nil {
+		return err
+	}
+	if err := adjustRefundedAmount(ctx, tx, refund, -1); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit refund: %w", err)
+	}
+	return nil
+}
+
+// CompleteRefund marks a pending refund completed, stages events and, when
+// change is not nil, moves the order to its new status in the same
+// transaction.
+func (r *repo) CompleteRefund(ctx context.Context, refund *domain.Refund, change *domain.StatusChange, events ...*domain.OutboxMessage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	updated, err := resolveRefund(ctx, tx, refund)
+	if err != nil || !updated {
+		return err
+	}
+	if change != nil {
+		if err := lockForTransition(ctx, tx, change); err != nil {
+			return err
+		}
+		updateQuery := `UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3`
+		if _, err := tx.ExecContext(ctx, updateQuery, change.ToStatus, change.CreatedAt, change.OrderID); err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		if err := insertStatusChange(ctx, tx, change); err != nil {
+			return err
+		}
+	}
+	for _, event := range events {
+		if err := outboxRepo.Insert(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit refund: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) ListPendingRefunds(ctx context.Context, updatedBefore time.Time, limit int) ([]*domain.Refund, error) {
+	var refunds []*domain.Refund
+	query := `
+		SELECT id, order_id, payment_id, COALESCE(provider_refund_id, '') AS provider_refund_id, amount, reason, actor,
+			status, COALESCE(failure_reason, '') AS failure_reason, created_at, updated_at
+		FROM refunds WHERE status = 'pending' AND updated_at < $1
+		ORDER BY updated_at LIMIT $2`
+	if err := r.db.SelectContext(ctx, &refunds, query, updatedBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to list pending refunds: %w", err)
+	}
+	if len(refunds) == 0 {
+		return refunds, nil
+	}
+
+	ids := make([]string, 0, len(refunds))
+	byID := make(map[string]*domain.Refund, len(refunds))
+	for _, refund := range refunds {
+		ids = append(ids, refund.ID)
+		byID[refund.ID] = refund
+	}
+	itemsQuery, args, err := sqlx.In(`
+		SELECT id, refund_id, order_item_id, product_id, category, quantity, amount
+		FROM refund_items WHERE refund_id IN (?) ORDER BY id`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build refund items query: %w", err)
+	}
+	var items []domain.RefundItem
+	if err := r.db.SelectContext(ctx, &items, r.db.Rebind(itemsQuery), args...); err != nil {
+		return nil, fmt.Errorf("failed to get refund items: %w", err)
+	}
+	for _, item := range items {
+		refund := byID[item.RefundID]
+		refund.Items = append(refund.Items, item)
+	}
+	return refunds, nil
+}
+
+// resolveRefund moves a pending refund to refund.Status. It reports false if
+// the refund had already been resolved.
+func resolveRefund(ctx context.Context, tx *sqlx.Tx, refund *domain.Refund) (bool, error) {
+	query := `
+		UPDATE refunds SET status = :status, provider_refund_id = NULLIF(:provider_refund_id, ''),
+			failure_reason = NULLIF(:failure_reason, ''), updated_at = :updated_at
+		WHERE id = :id AND status = 'pending'`
+	result, err := tx.NamedExecContext(ctx, query, refund)
+	if err != nil {
+		return false, fmt.Errorf("failed to update refund: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update refund: %w", err)
+	}
+	return n > 0, nil
+}
+
+// adjustRefundedQuantities adds (sign 1) or removes (sign -1) the refund's
+// quantities on its order items, refusing to refund more than was ordered.
+func adjustRefundedQuantities(ctx context.Context, tx *sqlx.Tx, refund *domain.Refund, sign int) error {
+	query := `
+		UPDATE order_items SET refunded_quantity = refunded_quantity + $1
+		WHERE id = $2 AND order_id = $3 AND refunded_quantity + $1 BETWEEN 0 AND quantity`
+	for _, item := range refund.Items {
+		result, err := tx.ExecContext(ctx, query, sign*item.Quantity, item.OrderItemID, refund.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to update refunded quantity: %w", err)
+		}
+		if n, err := result.RowsAffected(); err == nil && n == 0 {
+			return ErrRefundExceedsOrder
+		}
+	}
+	return nil
+}
+
+func adjustRefundedAmount(ctx context.Context, tx *sqlx.Tx, refund *domain.Refund, sign int) error {
+	query := `UPDATE orders SET refunded_amount = refunded_amount + $1, updated_at = $2 WHERE id = $3`
+	if _, err := tx.ExecContext(ctx, query, float64(sign)*refund.Amount, refund.UpdatedAt, refund.OrderID); err != nil {
+		return fmt.Errorf("failed to update refunded amount: %w", err)
+	}
+	return nil
+}
@@ -1,19 +1,341 @@
 package orderRepo
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"order_service/internal/domain"
+	idempotencyRepo "order_service/internal/repo/idempotency"
+	outboxRepo "order_service/internal/repo/outbox"
+	"strings"
+	"time"
+
 	"github.com/jmoiron/sqlx"
 )
 
+var ErrOrderNotFound = errors.New("order not found")
+
 type repo struct {
 	db *sqlx.DB
 }
 
+type ListFilter struct {
+	UserID      string
+	Status      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// Keyset cursor: only orders strictly older than (AfterCreatedAt, AfterID) are returned.
+	AfterCreatedAt *time.Time
+	AfterID        string
+	Limit          int
+}
+
 type Repo interface {
+	// Create stores the order and its events. A non-nil key is recorded in the
+	// same transaction; if the key was already used, nothing is written and
+	// idempotencyRepo.ErrKeyInUse is returned.
+	Create(ctx context.Context, order *domain.Order, key *domain.IdempotencyKey, events ...*domain.OutboxMessage) error
+	GetByID(ctx context.Context, orderID string) (*domain.Order, error)
+	List(ctx context.Context, filter *ListFilter) ([]*domain.Order, error)
+	UpdateStatus(ctx context.Context, change *domain.StatusChange, errorMessage string, events ...*domain.OutboxMessage) error
+	MarkPaid(ctx context.Context, change *domain.StatusChange, paymentID string, events ...*domain.OutboxMessage) error
+	GetStatusHistory(ctx context.Context, orderID string) ([]domain.StatusChange, error)
+	CreateRefund(ctx context.Context, refund *domain.Refund) error
+	FailRefund(ctx context.Context, refund *domain.Refund) error
+	CompleteRefund(ctx context.Context, refund *domain.Refund, change *domain.StatusChange, events ...*domain.OutboxMessage) error
+	// ListPendingRefunds returns up to limit refunds, with their items, that
+	// are still pending and were last updated before the given time.
+	ListPendingRefunds(ctx context.Context, updatedBefore time.Time, limit int) ([]*domain.Refund, error)
+	GetShipment(ctx context.Context, shipmentID string) (*domain.Shipment, error)
+	// CreateShipment stores the shipment. A non-nil change moves the order
+	// and stages events in the same transaction; without one the order must
+	// already be shipped.
+	CreateShipment(ctx context.Context, shipment *domain.Shipment, change *domain.StatusChange, events ...*domain.OutboxMessage) error
+	// DeliverShipment marks the shipment delivered and applies change once
+	// none of the order's shipments is in transit any more. It reports
+	// whether change was applied.
+	DeliverShipment(ctx context.Context, shipment *domain.Shipment, change *domain.StatusChange) (bool, error)
 }
 
+const orderColumns = `id, user_id, total_amount, refunded_amount, status, COALESCE(payment_id, '') AS payment_id,
+	COALESCE(error_message, '') AS error_message, created_at, updated_at`
+
 func NewRepo(db *sqlx.DB) Repo {
 
 	return &repo{
 		db: db,
 	}
 }
+
+// Create inserts the order, its items and any outbox events atomically.
+func (r *repo) Create(ctx context.Context, order *domain.Order, key *domain.IdempotencyKey, events ...*domain.OutboxMessage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if key != nil {
+		if err := idempotencyRepo.Insert(ctx, tx, key); err != nil {
+			return err
+		}
+	}
+
+	orderQuery := `
+		INSERT INTO orders (id, user_id, total_amount, status, payment_id, error_message, created_at, updated_at)
+		VALUES (:id, :user_id, :total_amount, :status, NULLIF(:payment_id, ''), NULLIF(:error_message, ''), :created_at, :updated_at)`
+	if _, err := tx.NamedExecContext(ctx, orderQuery, order); err != nil {
+		return fmt.Errorf("failed to insert order: %w", err)
+	}
+
+	itemQuery := `
+		INSERT INTO order_items (id, order_id, product_id, category, product_name, quantity, unit_price, line_total, created_at)
+		VALUES (:id, :order_id, :product_id, :category, :product_name, :quantity, :unit_price, :line_total, :created_at)`
+	for _, item := range order.Items {
+		if _, err := tx.NamedExecContext(ctx, itemQuery, item); err != nil {
+			return fmt.Errorf("failed to insert order item: %w", err)
+		}
+	}
+
+	if order.ShippingAddress != nil {
+		if err := insertShippingAddress(ctx, tx, order.ID, order.ShippingAddress); err != nil {
+			return err
+		}
+	}
+
+	initial := &domain.StatusChange{
+		OrderID:   order.ID,
+		ToStatus:  order.Status,
+		Actor:     order.UserID,
+		Reason:    "order created",
+		CreatedAt: order.CreatedAt,
+	}
+	if err := insertStatusChange(ctx, tx, initial); err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err := outboxRepo.Insert(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) GetByID(ctx context.Context, orderID string) (*domain.Order, error) {
+	var order domain.Order
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE id = $1`
+	if err := r.db.GetContext(ctx, &order, query, orderID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if err := r.attachDetails(ctx, []*domain.Order{&order}); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *repo) List(ctx context.Context, filter *ListFilter) ([]*domain.Order, error) {
+	var conditions []string
+	var args []any
+	addCondition := func(format string, values ...any) {
+		placeholders := make([]any, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		conditions = append(conditions, fmt.Sprintf(format, placeholders...))
+	}
+
+	if filter.UserID != "" {
+		addCondition("user_id = %s", filter.UserID)
+	}
+	if filter.Status != "" {
+		addCondition("status = %s", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		addCondition("created_at >= %s", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		addCondition("created_at < %s", *filter.CreatedTo)
+	}
+	if filter.AfterCreatedAt != nil {
+		addCondition("(created_at, id) < (%s, %s)", *filter.AfterCreatedAt, filter.AfterID)
+	}
+
+	query := `SELECT ` + orderColumns + ` FROM orders`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args))
+
+	orders := []*domain.Order{}
+	if err := r.db.SelectContext(ctx, &orders, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+	if err := r.attachDetails(ctx, orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// UpdateStatus moves an order to change.ToStatus if the state machine allows
+// it from the current status, and records the transition in the history.
+// change.FromStatus is filled in from the locked row. A non-empty
+// errorMessage replaces the order's error_message. events are staged in the
+// outbox within the same transaction.
+func (r *repo) UpdateStatus(ctx context.Context, change *domain.StatusChange, errorMessage string, events ...*domain.OutboxMessage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockForTransition(ctx, tx, change); err != nil {
+		return err
+	}
+
+	updateQuery := `
+		UPDATE orders SET status = $1, updated_at = $2, error_message = COALESCE(NULLIF($3, ''), error_message)
+		WHERE id = $4`
+	if _, err := tx.ExecContext(ctx, updateQuery, change.ToStatus, change.CreatedAt, errorMessage, change.OrderID); err != nil {
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+	if err := insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err := outboxRepo.Insert(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit status change: %w", err)
+	}
+	return nil
+}
+
+// MarkPaid moves an order to paid like UpdateStatus and records the captured
+// payment on it.
+func (r *repo) MarkPaid(ctx context.Context, change *domain.StatusChange, paymentID string, events ...*domain.OutboxMessage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockForTransition(ctx, tx, change); err != nil {
+		return err
+	}
+
+	updateQuery := `UPDATE orders SET status = $1, updated_at = $2, payment_id = $3 WHERE id = $4`
+	if _, err := tx.ExecContext(ctx, updateQuery, change.ToStatus, change.CreatedAt, paymentID, change.OrderID); err != nil {
+		return fmt.Errorf("failed to mark order paid: %w", err)
+	}
+	if err := insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err := outboxRepo.Insert(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit status change: %w", err)
+	}
+	return nil
+}
+
+// lockForTransition locks the order row and checks that the state machine
+// allows change, filling in change.FromStatus.
+func lockForTransition(ctx context.Context, tx *sqlx.Tx, change *domain.StatusChange) error {
+	var current string
+	if err := tx.GetContext(ctx, &current, `SELECT status FROM orders WHERE id = $1 FOR UPDATE`, change.OrderID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("failed to lock order: %w", err)
+	}
+	if !domain.CanTransition(current, change.ToStatus) {
+		return fmt.Errorf("%w: %s -> %s", domain.ErrInvalidTransition, current, change.ToStatus)
+	}
+	change.FromStatus = current
+	return nil
+}
+
+func (r *repo) GetStatusHistory(ctx context.Context, orderID string) ([]domain.StatusChange, error) {
+	history := []domain.StatusChange{}
+	query := `
+		SELECT id, order_id, from_status, to_status, actor, reason, created_at
+		FROM order_status_history WHERE order_id = $1 ORDER BY created_at, id`
+	if err := r.db.SelectContext(ctx, &history, query, orderID); err != nil {
+		return nil, fmt.Errorf("failed to get status history: %w", err)
+	}
+	return history, nil
+}
+
+// insertStatusChange records change in the history and fills in change.ID.
+func insertStatusChange(ctx context.Context, tx *sqlx.Tx, change *domain.StatusChange) error {
+	query := `
+		INSERT INTO order_status_history (order_id, from_status, to_status, actor, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`
+	err := tx.GetContext(ctx, &change.ID, query,
+		change.OrderID, change.FromStatus, change.ToStatus, change.Actor, change.Reason, change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert status history: %w", err)
+	}
+	return nil
+}
+
+// attachDetails loads the items, shipping addresses and shipments of all
+// given orders, one query each.
+func (r *repo) attachDetails(ctx context.Context, orders []*domain.Order) error {
+	if err := r.attachItems(ctx, orders); err != nil {
+		return err
+	}
+	if err := r.attachShippingAddresses(ctx, orders); err != nil {
+		return err
+	}
+	return r.attachShipments(ctx, orders)
+}
+
+// attachItems loads the line items of all given orders with a single query.
+func (r *repo) attachItems(ctx context.Context, orders []*domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(orders))
+	byID := make(map[string]*domain.Order, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+		byID[order.ID] = order
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT id, order_id, product_id, category, product_name, quantity, unit_price, line_total, refunded_quantity, created_at
+		FROM order_items WHERE order_id IN (?) ORDER BY created_at, id`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to build order items query: %w", err)
+	}
+
+	var items []domain.OrderItem
+	if err := r.db.SelectContext(ctx, &items, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to get order items: %w", err)
+	}
+	for _, item := range items {
+		order := byID[item.OrderID]
+		order.Items = append(order.Items, item)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package orderRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"order_service/internal/domain"
+	outboxRepo "order_service/internal/repo/outbox"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrShipmentNotFound = errors.New("shipment not found")
+	// ErrTrackingNumberInUse is returned when the carrier's tracking number
+	// was already recorded for another shipment.
+	ErrTrackingNumberInUse = errors.New("tracking number already in use")
+	// ErrShipmentNotInTransit is returned when delivering a shipment that
+	// was already delivered.
+	ErrShipmentNotInTransit = errors.New("shipment is not in transit")
+)
+
+const shipmentColumns = `id, order_id, carrier, tracking_number, status, created_by, shipped_at, delivered_at, updated_at`
+
+func (r *repo) GetShipment(ctx context.Context, shipmentID string) (*domain.Shipment, error) {
+	var shipment domain.Shipment
+	err := r.db.GetContext(ctx, &shipment, `SELECT `+shipmentColumns+` FROM shipments WHERE id = $1`, shipmentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrShipmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipment: %w", err)
+	}
+	return &shipment, nil
+}
+
+func (r *repo) CreateShipment(ctx context.Context, shipment *domain.Shipment, change *domain.StatusChange, events ...*domain.OutboxMessage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if change != nil {
+		if err := lockForTransition(ctx, tx, change); err != nil {
+			return err
+		}
+	} else if err := lockShipped(ctx, tx, shipment.OrderID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO shipments (id, order_id, carrier, tracking_number, status, created_by, shipped_at, updated_at)
+		VALUES (:id, :order_id, :carrier, :tracking_number, :status, :created_by, :shipped_at, :updated_at)
+		ON CONFLICT (carrier, tracking_number) DO NOTHING`
+	result, err := tx.NamedExecContext(ctx, query, shipment)
+	if err != nil {
+		return fmt.Errorf("failed to insert shipment: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to insert shipment: %w", err)
+	}
+	if inserted == 0 {
+		return ErrTrackingNumberInUse
+	}
+
+	if change != nil {
+		if err := applyStatusChange(ctx, tx, change); err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := outboxRepo.Insert(ctx, tx, event); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit shipment: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) DeliverShipment(ctx context.Context, shipment *domain.Shipment, change *domain.StatusChange) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the order first so concurrent deliveries of its shipments see
+	// each other and exactly one of them completes the order.
+	if err := lockShipped(ctx, tx, shipment.OrderID); err != nil {
+		return false, err
+	}
+
+	query := `
+		UPDATE shipments SET status = $1, delivered_at = $2, updated_at = $2
+		WHERE id = $3 AND status = $4`
+	result, err := tx.ExecContext(ctx, query, domain.ShipmentStatusDelivered, shipment.DeliveredAt, shipment.ID, domain.ShipmentStatusInTransit)
+	if err != nil {
+		return false, fmt.Errorf("failed to deliver shipment: %w", err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to deliver shipment: %w", err)
+	}
+	if updated == 0 {
+		return false, ErrShipmentNotInTransit
+	}
+
+	var inTransit int
+	countQuery := `SELECT COUNT(*) FROM shipments WHERE order_id = $1 AND status = $2`
+	if err := tx.GetContext(ctx, &inTransit, countQuery, shipment.OrderID, domain.ShipmentStatusInTransit); err != nil {
+		return false, fmt.Errorf("failed to count shipments in transit: %w", err)
+	}
+	if inTransit == 0 {
+		if err := lockForTransition(ctx, tx, change); err != nil {
+			return false, err
+		}
+		if err := applyStatusChange(ctx, tx, change); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit shipment delivery: %w", err)
+	}
+	return inTransit == 0, nil
+}
+
+// lockShipped locks the order row and checks that it is shipped.
+func lockShipped(ctx context.Context, tx *sqlx.Tx, orderID string) error {
+	var current string
+	if err := tx.GetContext(ctx, &current, `SELECT status FROM orders WHERE id = $1 FOR UPDATE`, orderID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("failed to lock order: %w", err)
+	}
+	if current != domain.OrderStatusShipped {
+		return fmt.Errorf("%w: order is %s", domain.ErrInvalidTransition, current)
+	}
+	return nil
+}
+
+// applyStatusChange writes a change checked by lockForTransition.
+func applyStatusChange(ctx context.Context, tx *sqlx.Tx, change *domain.StatusChange) error {
+	updateQuery := `UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3`
+	if _, err := tx.ExecContext(ctx, updateQuery, change.ToStatus, change.CreatedAt, change.OrderID); err != nil {
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+	return insertStatusChange(ctx, tx, change)
+}
+
+func insertShippingAddress(ctx context.Context, tx *sqlx.Tx, orderID string, address *domain.Address) error {
+	query := `
+		INSERT INTO order_shipping_addresses (order_id, recipient_name, line1, line2, city, state, postal_code, country, phone)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := tx.ExecContext(ctx, query, orderID, address.RecipientName, address.Line1, address.Line2,
+		address.City, address.State, address.PostalCode, address.Country, address.Phone)
+	if err != nil {
+		return fmt.Errorf("failed to insert shipping address: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) attachShippingAddresses(ctx context.Context, orders []*domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	byID := make(map[string]*domain.Order, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+		byID[order.ID] = order
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT order_id, recipient_name, line1, line2, city, state, postal_code, country, phone
+		FROM order_shipping_addresses WHERE order_id IN (?)`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to build shipping address query: %w", err)
+	}
+
+	var rows []struct {
+		OrderID string `db:"order_id"`
+		domain.Address
+	}
+	if err := r.db.SelectContext(ctx, &rows, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to get shipping addresses: %w", err)
+	}
+	for i := range rows {
+		byID[rows[i].OrderID].ShippingAddress = &rows[i].Address
+	}
+	return nil
+}
+
+func (r *repo) attachShipments(ctx context.Context, orders []*domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	byID := make(map[string]*domain.Order, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+		byID[order.ID] = order
+	}
+
+	query, args, err := sqlx.In(`SELECT `+shipmentColumns+` FROM shipments WHERE order_id IN (?) ORDER BY shipped_at, id`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to build shipments query: %w", err)
+	}
+
+	var shipments []domain.Shipment
+	if err := r.db.SelectContext(ctx, &shipments, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to get shipments: %w", err)
+	}
+	for _, shipment := range shipments {
+		order := byID[shipment.OrderID]
+		order.Shipments = append(order.Shipments, shipment)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package outboxRepo
+
+import (
+	"context"
+	"fmt"
+	"order_service/internal/domain"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type repo struct {
+	db *sqlx.DB
+}
+
+type Repo interface {
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxMessage, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error
+}
+
+func NewRepo(db *sqlx.DB) Repo {
+
+	return &repo{
+		db: db,
+	}
+}
+
+// Insert stages a message inside the caller's transaction so it is only
+// visible to the relay if the surrounding business write commits.
+func Insert(ctx context.Context, tx *sqlx.Tx, msg *domain.OutboxMessage) error {
+	query := `
+		INSERT INTO outbox (aggregate_id, event_type, event_key, payload, envelope, created_at, next_attempt_at)
+		VALUES (:aggregate_id, :event_type, :event_key, :payload, :envelope, :created_at, :created_at)`
+	if _, err := tx.NamedExecContext(ctx, query, msg); err != nil {
+		return fmt.Errorf("failed to insert outbox message: %w", err)
+	}
+	return nil
+}
+
+// claimLockID serializes Claim across relays, so one relay cannot claim a
+// message while another is still claiming an earlier one with the same key.
+const claimLockID = 7319220052
+
+// Claim returns up to limit due messages and pushes their next_attempt_at
+// forward by lease, so concurrent relays skip them and a crashed relay's
+// messages become due again once the lease runs out. A message is not due
+// while an earlier unsent message with the same key is leased or waiting for
+// a retry, which keeps the messages of one key in order.
+func (r *repo) Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxMessage, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, claimLockID); err != nil {
+		return nil, fmt.Errorf("failed to lock outbox: %w", err)
+	}
+	query := `
+		UPDATE outbox SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT o.id FROM outbox o
+			WHERE o.sent_at IS NULL AND o.next_attempt_at <= NOW()
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox earlier
+				WHERE earlier.event_key = o.event_key AND earlier.id < o.id
+				AND earlier.sent_at IS NULL AND earlier.next_attempt_at > NOW()
+			)
+			ORDER BY o.id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, aggregate_id, event_type, event_key, payload, envelope, attempts, next_attempt_at, created_at`
+
+	messages := []domain.OutboxMessage{}
+	if err := tx.SelectContext(ctx, &messages, query, limit, lease.Milliseconds()); err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+	return messages, nil
+}
+
+func (r *repo) MarkSent(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE outbox SET sent_at = NOW(), last_error = NULL WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to mark outbox message sent: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error {
+	query := `UPDATE outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`
+	if _, err := r.db.ExecContext(ctx, query, id, reason, nextAttemptAt); err != nil {
+		return fmt.Errorf("failed to mark outbox message failed: %w", err)
+	}
+	return nil
+}
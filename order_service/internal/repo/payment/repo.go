@@ -0,0 +1,112 @@
+package paymentRepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"order_service/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrPaymentNotFound = errors.New("payment not found")
+
+type repo struct {
+	db *sqlx.DB
+}
+
+type Repo interface {
+	Create(ctx context.Context, payment *domain.Payment) error
+	GetByID(ctx context.Context, paymentID string) (*domain.Payment, error)
+	GetByAuthorizationID(ctx context.Context, provider, authorizationID string) (*domain.Payment, error)
+	// GetOpenByOrder returns the order's latest payment that is still pending
+	// or authorized.
+	GetOpenByOrder(ctx context.Context, orderID string) (*domain.Payment, error)
+	Update(ctx context.Context, payment *domain.Payment) error
+	// WithOrderLock runs fn while holding a lock on the order's payments, so
+	// confirmations and callbacks for one order never run concurrently.
+	WithOrderLock(ctx context.Context, orderID string, fn func() error) error
+}
+
+// orderLockSpace is the first key of the advisory locks taken on orders; the
+// second is a hash of the order id.
+const orderLockSpace = 7319220053
+
+const paymentColumns = `id, order_id, provider, COALESCE(authorization_id, '') AS authorization_id,
+	COALESCE(capture_id, '') AS capture_id, amount, currency, status,
+	COALESCE(failure_reason, '') AS failure_reason, created_at, updated_at`
+
+func NewRepo(db *sqlx.DB) Repo {
+
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Create(ctx context.Context, payment *domain.Payment) error {
+	query := `
+		INSERT INTO payments (id, order_id, provider, authorization_id, capture_id, amount, currency, status, failure_reason, created_at, updated_at)
+		VALUES (:id, :order_id, :provider, NULLIF(:authorization_id, ''), NULLIF(:capture_id, ''), :amount, :currency, :status,
+			NULLIF(:failure_reason, ''), :created_at, :updated_at)`
+	if _, err := r.db.NamedExecContext(ctx, query, payment); err != nil {
+		return fmt.Errorf("failed to insert payment: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) GetByID(ctx context.Context, paymentID string) (*domain.Payment, error) {
+	return r.get(ctx, `SELECT `+paymentColumns+` FROM payments WHERE id = $1`, paymentID)
+}
+
+func (r *repo) GetByAuthorizationID(ctx context.Context, provider, authorizationID string) (*domain.Payment, error) {
+	return r.get(ctx, `SELECT `+paymentColumns+` FROM payments WHERE provider = $1 AND authorization_id = $2`, provider, authorizationID)
+}
+
+func (r *repo) GetOpenByOrder(ctx context.Context, orderID string) (*domain.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments
+		WHERE order_id = $1 AND status IN ($2, $3)
+		ORDER BY created_at DESC LIMIT 1`
+	return r.get(ctx, query, orderID, domain.PaymentStatusPending, domain.PaymentStatusAuthorized)
+}
+
+// Update persists the payment's mutable fields.
+func (r *repo) Update(ctx context.Context, payment *domain.Payment) error {
+	query := `
+		UPDATE payments SET status = :status, authorization_id = NULLIF(:authorization_id, ''),
+			capture_id = NULLIF(:capture_id, ''), failure_reason = NULLIF(:failure_reason, ''), updated_at = :updated_at
+		WHERE id = :id`
+	result, err := r.db.NamedExecContext(ctx, query, payment)
+	if err != nil {
+		return fmt.Errorf("failed to update payment: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrPaymentNotFound
+	}
+	return nil
+}
+
+func (r *repo) WithOrderLock(ctx context.Context, orderID string, fn func() error) error {
+	conn, err := r.db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1, hashtext($2))`, orderLockSpace, orderID); err != nil {
+		return fmt.Errorf("failed to lock order payments: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1, hashtext($2))`, orderLockSpace, orderID)
+	return fn()
+}
+
+func (r *repo) get(ctx context.Context, query string, args ...any) (*domain.Payment, error) {
+	var payment domain.Payment
+	if err := r.db.GetContext(ctx, &payment, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+	return &payment, nil
+}
@@ -0,0 +1,57 @@
+package processedEventRepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type repo struct {
+	db *sqlx.DB
+}
+
+// Repo remembers which events each consumer group has processed so
+// redelivered events can be skipped.
+type Repo interface {
+	Seen(ctx context.Context, consumerGroup, eventID string) (bool, error)
+	MarkProcessed(ctx context.Context, consumerGroup, eventID string) error
+	// DeleteProcessedBefore forgets events processed before the given time
+	// and returns how many were deleted.
+	DeleteProcessedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+func NewRepo(db *sqlx.DB) Repo {
+
+	return &repo{
+		db: db,
+	}
+}
+
+func (r *repo) Seen(ctx context.Context, consumerGroup, eventID string) (bool, error) {
+	var seen bool
+	query := `SELECT EXISTS (SELECT 1 FROM processed_events WHERE consumer_group = $1 AND event_id = $2)`
+	if err := r.db.GetContext(ctx, &seen, query, consumerGroup, eventID); err != nil {
+		return false, fmt.Errorf("failed to check processed event: %w", err)
+	}
+	return seen, nil
+}
+
+func (r *repo) MarkProcessed(ctx context.Context, consumerGroup, eventID string) error {
+	query := `
+		INSERT INTO processed_events (consumer_group, event_id) VALUES ($1, $2)
+		ON CONFLICT (consumer_group, event_id) DO NOTHING`
+	if _, err := r.db.ExecContext(ctx, query, consumerGroup, eventID); err != nil {
+		return fmt.Errorf("failed to mark event processed: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) DeleteProcessedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM processed_events WHERE processed_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete processed events: %w", err)
+	}
+	return result.RowsAffected()
+}
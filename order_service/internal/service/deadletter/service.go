@@ -0,0 +1,80 @@
+package deadLetterService
+
+import (
+	"context"
+	"errors"
+	"log"
+	"order_service/internal/domain"
+	"order_service/internal/kafka"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultListLimit = 50
+
+type service struct {
+	store kafka.DeadLetterStore
+}
+
+type Service interface {
+	ListDeadLetters(ctx context.Context, role string, req *orderpb.ListDeadLettersRequest) (*orderpb.ListDeadLettersResponse, error)
+	ReplayDeadLetter(ctx context.Context, actor, role string, req *orderpb.ReplayDeadLetterRequest) (*orderpb.DeadLetter, error)
+}
+
+func NewService(store kafka.DeadLetterStore) Service {
+
+	return &service{
+		store: store,
+	}
+}
+
+func (s *service) ListDeadLetters(ctx context.Context, role string, req *orderpb.ListDeadLettersRequest) (*orderpb.ListDeadLettersResponse, error) {
+	if !domain.IsAdmin(role) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can list dead letters")
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	letters, err := s.store.List(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &orderpb.ListDeadLettersResponse{
+		DeadLetters: make([]*orderpb.DeadLetter, 0, len(letters)),
+	}
+	for _, letter := range letters {
+		resp.DeadLetters = append(resp.DeadLetters, utils.DeadLetterToProto(letter))
+	}
+	return resp, nil
+}
+
+// ReplayDeadLetter publishes a dead-lettered validation result to the order
+// results topic again. Replaying is safe to repeat: results for orders that
+// already left pending are skipped by the consumer.
+func (s *service) ReplayDeadLetter(ctx context.Context, actor, role string, req *orderpb.ReplayDeadLetterRequest) (*orderpb.DeadLetter, error) {
+	if !domain.IsAdmin(role) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can replay dead letters")
+	}
+
+	letter, err := s.store.Get(ctx, int(req.Partition), req.Offset)
+	if errors.Is(err, kafka.ErrDeadLetterNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if letter.OriginalTopic != s.store.ReplayTopic() {
+		return nil, status.Errorf(codes.FailedPrecondition, "dead letter came from %q, only %s messages can be replayed", letter.OriginalTopic, s.store.ReplayTopic())
+	}
+
+	if err := s.store.Replay(ctx, letter); err != nil {
+		return nil, err
+	}
+	log.Printf("dead letter %d/%d replayed to %s by %s", letter.Partition, letter.Offset, s.store.ReplayTopic(), actor)
+	return utils.DeadLetterToProto(letter), nil
+}
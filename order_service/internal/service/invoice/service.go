@@ -0,0 +1,122 @@
+package invoiceService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	userclient "order_service/internal/clients/user"
+	"order_service/internal/domain"
+	"order_service/internal/invoice"
+	invoiceRepo "order_service/internal/repo/invoice"
+	orderRepo "order_service/internal/repo/order"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Settings are the business details applied to newly issued invoices.
+type Settings struct {
+	Seller       invoice.Seller
+	NumberPrefix string
+	// TaxRate is included in order prices, e.g. 0.15 for 15%.
+	TaxRate  float64
+	Currency string
+}
+
+type service struct {
+	orders   orderRepo.Repo
+	invoices invoiceRepo.Repo
+	users    userclient.Client
+	settings Settings
+}
+
+type Service interface {
+	GetInvoice(ctx context.Context, email, role, orderID string) (*orderpb.InvoiceResponse, error)
+}
+
+func NewService(orders orderRepo.Repo, invoices invoiceRepo.Repo, users userclient.Client, settings Settings) Service {
+	return &service{
+		orders:   orders,
+		invoices: invoices,
+		users:    users,
+		settings: settings,
+	}
+}
+
+// GetInvoice returns the invoice of a paid order, issuing it on first request,
+// with what was refunded on the order since. Owners see their own orders'
+// invoices, admins any.
+func (s *service) GetInvoice(ctx context.Context, email, role, orderID string) (*orderpb.InvoiceResponse, error) {
+	order, err := s.orders.GetByID(ctx, orderID)
+	if errors.Is(err, orderRepo.ErrOrderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != email && !domain.IsAdmin(role) {
+		return nil, status.Error(codes.NotFound, orderRepo.ErrOrderNotFound.Error())
+	}
+
+	inv, err := s.invoices.GetByOrderID(ctx, order.ID)
+	if errors.Is(err, invoiceRepo.ErrInvoiceNotFound) {
+		inv, err = s.issue(ctx, order)
+	}
+	if err != nil {
+		return nil, err
+	}
+	inv.Items = order.Items
+	inv.RefundedAmount = order.RefundedAmount
+
+	return &orderpb.InvoiceResponse{
+		Invoice:     utils.InvoiceToProto(inv, s.settings.Seller),
+		Pdf:         invoice.RenderPDF(inv, s.settings.Seller),
+		PdfFilename: invoice.Filename(inv),
+	}, nil
+}
+
+// issue numbers and stores a new invoice for order. If another request
+// issued it meanwhile, that invoice is returned instead.
+func (s *service) issue(ctx context.Context, order *domain.Order) (*domain.Invoice, error) {
+	if !domain.CanInvoice(order.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "order in status %s cannot be invoiced yet", order.Status)
+	}
+
+	inv := &domain.Invoice{
+		ID:         uuid.New().String(),
+		OrderID:    order.ID,
+		BuyerName:  order.UserID,
+		BuyerEmail: order.UserID,
+		Currency:   s.settings.Currency,
+		TaxRate:    s.settings.TaxRate,
+		Total:      utils.RoundAmount(order.TotalAmount),
+		IssuedAt:   time.Now().UTC(),
+	}
+	inv.TaxAmount = utils.RoundAmount(inv.Total - inv.Total/(1+inv.TaxRate))
+	inv.Subtotal = utils.RoundAmount(inv.Total - inv.TaxAmount)
+
+	customer, err := s.users.GetCustomerByEmail(ctx, order.UserID)
+	switch status.Code(err) {
+	case codes.OK:
+		inv.BuyerName = customer.Name
+		inv.BuyerPhone = customer.Phone
+		inv.BuyerAddress = customer.Address
+	case codes.NotFound:
+		// The account is gone; the invoice still names the buyer by email.
+	default:
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch buyer details: %v", err)
+	}
+
+	err = s.invoices.Create(ctx, inv, s.settings.NumberPrefix)
+	if errors.Is(err, invoiceRepo.ErrInvoiceExists) {
+		return s.invoices.GetByOrderID(ctx, order.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue invoice: %w", err)
+	}
+	return inv, nil
+}
@@ -0,0 +1,54 @@
+package orderService
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"order_service/internal/domain"
+	orderpb "order_service/proto/gen"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// replayCreateOrder returns the stored response for the key, or
+// idempotencyRepo.ErrKeyNotFound when the key is new or expired.
+func (s *service) replayCreateOrder(ctx context.Context, email, idempotencyKey, hash string) (*orderpb.CreateOrderResponse, error) {
+	key, err := s.keys.Get(ctx, email, domain.OperationCreateOrder, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if key.RequestHash != hash {
+		return nil, status.Error(codes.AlreadyExists, "idempotency-key was already used for a different request")
+	}
+
+	var resp orderpb.CreateOrderResponse
+	if err := proto.Unmarshal(key.Response, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored order response: %w", err)
+	}
+	return &resp, nil
+}
+
+// RunIdempotencySweeper deletes expired idempotency keys every interval
+// until ctx is cancelled.
+func (s *service) RunIdempotencySweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.keys.DeleteExpired(ctx)
+			if err != nil {
+				log.Printf("failed to delete expired idempotency keys: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("deleted %d expired idempotency keys", n)
+			}
+		}
+	}
+}
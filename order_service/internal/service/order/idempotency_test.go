@@ -0,0 +1,59 @@
+package orderService
+
+import (
+	"context"
+	"order_service/internal/domain"
+	idempotencyRepo "order_service/internal/repo/idempotency"
+	orderpb "order_service/proto/gen"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type stubKeys struct {
+	idempotencyRepo.Repo
+	key *domain.IdempotencyKey
+}
+
+func (s *stubKeys) Get(ctx context.Context, userID, operation, key string) (*domain.IdempotencyKey, error) {
+	if s.key == nil || s.key.UserID != userID || s.key.Operation != operation || s.key.Key != key {
+		return nil, idempotencyRepo.ErrKeyNotFound
+	}
+	return s.key, nil
+}
+
+func TestReplayCreateOrder(t *testing.T) {
+	stored, err := proto.Marshal(&orderpb.CreateOrderResponse{OrderId: "order-1", Status: domain.OrderStatusPending})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &service{keys: &stubKeys{key: &domain.IdempotencyKey{
+		UserID:      "a@example.com",
+		Operation:   domain.OperationCreateOrder,
+		Key:         "key-1",
+		RequestHash: "hash-1",
+		Response:    stored,
+	}}}
+	ctx := context.Background()
+
+	resp, err := s.replayCreateOrder(ctx, "a@example.com", "key-1", "hash-1")
+	if err != nil {
+		t.Fatalf("replay of the same request: %v", err)
+	}
+	if resp.OrderId != "order-1" {
+		t.Errorf("replayed order %q, want order-1", resp.OrderId)
+	}
+
+	_, err = s.replayCreateOrder(ctx, "a@example.com", "key-1", "hash-2")
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("reused key: got %v, want AlreadyExists", err)
+	}
+
+	// Keys are scoped to the user who sent them.
+	_, err = s.replayCreateOrder(ctx, "b@example.com", "key-1", "hash-1")
+	if err != idempotencyRepo.ErrKeyNotFound {
+		t.Errorf("other user's key: got %v, want ErrKeyNotFound", err)
+	}
+}
@@ -1,24 +1,455 @@
 package orderService
 
 import (
-	"order_service/internal/kafka"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	cartclient "order_service/internal/clients/cart"
+	client "order_service/internal/clients/product"
+	"order_service/internal/domain"
+	"order_service/internal/pubsub"
+	idempotencyRepo "order_service/internal/repo/idempotency"
 	orderRepo "order_service/internal/repo/order"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+	productpb "order_service/proto/gen/product"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type service struct {
-	repo     orderRepo.Repo
-	producer kafka.Producer
-	consumer kafka.Consumer
+	repo           orderRepo.Repo
+	keys           idempotencyRepo.Repo
+	productClient  client.Client
+	cartClient     cartclient.Client
+	notifier       pubsub.StatusNotifier
+	idempotencyTTL time.Duration
 }
 
 type Service interface {
+	CreateOrder(ctx context.Context, email, idempotencyKey string, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error)
+	Checkout(ctx context.Context, email string, req *orderpb.CheckoutRequest) (*orderpb.CreateOrderResponse, error)
+	GetOrder(ctx context.Context, email, orderID string) (*orderpb.GetOrderResponse, error)
+	ListMyOrders(ctx context.Context, email string, req *orderpb.ListMyOrdersRequest) (*orderpb.ListOrdersResponse, error)
+	ListOrders(ctx context.Context, role string, req *orderpb.ListOrdersRequest) (*orderpb.ListOrdersResponse, error)
+	GetStatusHistory(ctx context.Context, email, role, orderID string) (*orderpb.OrderStatusHistoryResponse, error)
+	CancelOrder(ctx context.Context, email, orderID, reason string) (*orderpb.GetOrderResponse, error)
+	WatchOrder(ctx context.Context, email, orderID string, send func(*orderpb.OrderStatusUpdate) error) error
+	CreateShipment(ctx context.Context, actor, role string, req *orderpb.CreateShipmentRequest) (*domain.Shipment, error)
+	MarkShipmentDelivered(ctx context.Context, actor, role, shipmentID string) (*domain.Shipment, error)
+	HandleValidationResult(ctx context.Context, result *orderpb.OrderValidationResultEvent) error
+	RunIdempotencySweeper(ctx context.Context, interval time.Duration)
 }
 
-func NewService(producer kafka.Producer, consumer kafka.Consumer, repo orderRepo.Repo) Service {
+var errUnauthenticated = status.Error(codes.Unauthenticated, "unauthorized")
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func NewService(repo orderRepo.Repo, keys idempotencyRepo.Repo, productClient client.Client, cartClient cartclient.Client, notifier pubsub.StatusNotifier, idempotencyTTL time.Duration) Service {
 
 	return &service{
-		producer: producer,
-		consumer: consumer,
-		repo:     repo,
+		repo:           repo,
+		keys:           keys,
+		productClient:  productClient,
+		cartClient:     cartClient,
+		notifier:       notifier,
+		idempotencyTTL: idempotencyTTL,
+	}
+}
+
+// CreateOrder places an order. With an idempotency key, the first successful
+// response is stored and replayed for repeats of the same request until the
+// key expires; reusing the key for a different request is a conflict.
+func (s *service) CreateOrder(ctx context.Context, email, idempotencyKey string, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
+	if email == "" {
+		return nil, errUnauthenticated
+	}
+	if idempotencyKey == "" {
+		return s.placeOrder(ctx, email, req.Items, req.ShippingAddress, nil)
+	}
+
+	hash, err := utils.RequestHash(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.replayCreateOrder(ctx, email, idempotencyKey, hash)
+	if resp != nil || !errors.Is(err, idempotencyRepo.ErrKeyNotFound) {
+		return resp, err
+	}
+
+	key := &domain.IdempotencyKey{
+		UserID:      email,
+		Operation:   domain.OperationCreateOrder,
+		Key:         idempotencyKey,
+		RequestHash: hash,
+	}
+	resp, err = s.placeOrder(ctx, email, req.Items, req.ShippingAddress, key)
+	if errors.Is(err, idempotencyRepo.ErrKeyInUse) {
+		// A concurrent request with the same key committed first.
+		return s.replayCreateOrder(ctx, email, idempotencyKey, hash)
+	}
+	return resp, err
+}
+
+func (s *service) Checkout(ctx context.Context, email string, req *orderpb.CheckoutRequest) (*orderpb.CreateOrderResponse, error) {
+	if email == "" {
+		return nil, errUnauthenticated
+	}
+
+	cart, err := s.cartClient.GetCart(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cart: %w", err)
+	}
+	if len(cart.Items) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "cart is empty")
+	}
+
+	reqItems := make([]*orderpb.CreateOrderItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		reqItems = append(reqItems, &orderpb.CreateOrderItem{
+			ProductId: item.ProductId,
+			Category:  item.Category,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	resp, err := s.placeOrder(ctx, email, reqItems, req.ShippingAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order is committed at this point, so a failed clear only leaves a
+	// stale cart behind and must not fail the checkout. Only the version
+	// that was checked out is cleared: if the cart changed since it was
+	// read, it is left alone rather than dropping items that were not
+	// ordered.
+	if err := s.cartClient.ClearCart(ctx, email, cart.Version); err != nil {
+		log.Printf("order %s placed but cart for %s was not cleared: %v", resp.OrderId, email, err)
+	}
+	return resp, nil
+}
+
+// placeOrder prices and stores a new order shipping to address. A non-nil
+// key is completed with the response and stored alongside the order.
+func (s *service) placeOrder(ctx context.Context, email string, reqItems []*orderpb.CreateOrderItem, address *orderpb.ShippingAddress, key *domain.IdempotencyKey) (*orderpb.CreateOrderResponse, error) {
+	now := time.Now().UTC()
+	order := &domain.Order{
+		ID:              uuid.New().String(),
+		UserID:          email,
+		Status:          domain.OrderStatusPending,
+		ShippingAddress: utils.AddressFromProto(address),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	items, err := s.priceItems(ctx, order.ID, reqItems, now)
+	if err != nil {
+		return nil, err
+	}
+	order.Items = items
+	for _, item := range items {
+		order.TotalAmount += item.LineTotal
+	}
+	order.TotalAmount = utils.RoundAmount(order.TotalAmount)
+
+	event, err := utils.NewOutboxMessage(ctx, order.ID, domain.EventTypeOrderCreated, &orderpb.OrderCreatedEvent{
+		OrderId: order.ID,
+		UserId:  order.UserID,
+		Items:   utils.OrderItemsToEvent(order.Items),
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &orderpb.CreateOrderResponse{
+		OrderId:         order.ID,
+		Status:          order.Status,
+		TotalAmount:     order.TotalAmount,
+		Items:           utils.OrderItemsToProto(order.Items),
+		ShippingAddress: utils.AddressToProto(order.ShippingAddress),
+	}
+	if key != nil {
+		if key.Response, err = proto.Marshal(resp); err != nil {
+			return nil, fmt.Errorf("failed to marshal order response: %w", err)
+		}
+		key.CreatedAt = now
+		key.ExpiresAt = now.Add(s.idempotencyTTL)
+	}
+	if err := s.repo.Create(ctx, order, key, event); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *service) GetOrder(ctx context.Context, email, orderID string) (*orderpb.GetOrderResponse, error) {
+	order, err := s.getOwnedOrder(ctx, email, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderpb.GetOrderResponse{Order: utils.OrderToProto(order)}, nil
+}
+
+func (s *service) ListMyOrders(ctx context.Context, email string, req *orderpb.ListMyOrdersRequest) (*orderpb.ListOrdersResponse, error) {
+	if email == "" {
+		return nil, errUnauthenticated
+	}
+	filter := &orderRepo.ListFilter{
+		UserID: email,
+		Status: req.Status,
+	}
+	return s.listOrders(ctx, filter, req.PageSize, req.Cursor)
+}
+
+func (s *service) ListOrders(ctx context.Context, role string, req *orderpb.ListOrdersRequest) (*orderpb.ListOrdersResponse, error) {
+	if !domain.IsAdmin(role) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can list all orders")
+	}
+	filter := &orderRepo.ListFilter{
+		UserID: req.UserId,
+		Status: req.Status,
+	}
+	if req.CreatedFrom != nil {
+		from := req.CreatedFrom.AsTime()
+		filter.CreatedFrom = &from
+	}
+	if req.CreatedTo != nil {
+		to := req.CreatedTo.AsTime()
+		filter.CreatedTo = &to
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, status.Error(codes.InvalidArgument, "created_from must be before created_to")
+	}
+	return s.listOrders(ctx, filter, req.PageSize, req.Cursor)
+}
+
+func (s *service) listOrders(ctx context.Context, filter *orderRepo.ListFilter, pageSize int32, cursor string) (*orderpb.ListOrdersResponse, error) {
+	limit := int(pageSize)
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	if cursor != "" {
+		createdAt, id, err := utils.DecodeCursor(cursor)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.AfterCreatedAt = &createdAt
+		filter.AfterID = id
+	}
+	// Fetch one extra row to learn whether another page exists.
+	filter.Limit = limit + 1
+
+	orders, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &orderpb.ListOrdersResponse{}
+	if len(orders) > limit {
+		orders = orders[:limit]
+		last := orders[len(orders)-1]
+		resp.NextCursor = utils.EncodeCursor(last.CreatedAt, last.ID)
+	}
+	resp.Orders = make([]*orderpb.Order, 0, len(orders))
+	for _, order := range orders {
+		resp.Orders = append(resp.Orders, utils.OrderToProto(order))
+	}
+	return resp, nil
+}
+
+func (s *service) GetStatusHistory(ctx context.Context, email, role, orderID string) (*orderpb.OrderStatusHistoryResponse, error) {
+	var order *domain.Order
+	var err error
+	if domain.IsAdmin(role) {
+		order, err = s.repo.GetByID(ctx, orderID)
+		if errors.Is(err, orderRepo.ErrOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+	} else {
+		order, err = s.getOwnedOrder(ctx, email, orderID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.repo.GetStatusHistory(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderpb.OrderStatusHistoryResponse{
+		OrderId: order.ID,
+		Status:  order.Status,
+		History: utils.StatusHistoryToProto(history),
+	}, nil
+}
+
+// HandleValidationResult records the inventory verdict for a pending order.
+// Results for unknown orders or orders that already left pending (e.g. a
+// redelivered message) are skipped so the consumer can move on.
+func (s *service) HandleValidationResult(ctx context.Context, result *orderpb.OrderValidationResultEvent) error {
+	toStatus, reason, errorMessage := domain.OrderStatusValidated, "inventory validated", ""
+	if !result.IsValid {
+		toStatus, reason, errorMessage = domain.OrderStatusRejected, result.ErrorMessage, result.ErrorMessage
+	}
+
+	err := s.updateStatus(ctx, result.OrderId, toStatus, domain.ActorSystem, reason, errorMessage)
+	if errors.Is(err, orderRepo.ErrOrderNotFound) || errors.Is(err, domain.ErrInvalidTransition) {
+		log.Printf("skipping validation result for order %s: %v", result.OrderId, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("Order %s processed. Status: %s", result.OrderId, toStatus)
+	return nil
+}
+
+// CancelOrder cancels the caller's order if it has not been paid yet and
+// stages an OrderCancelledEvent so product_service releases its reserved
+// stock. Paid orders have to be refunded instead. Cancelling an already
+// cancelled order returns it unchanged.
+func (s *service) CancelOrder(ctx context.Context, email, orderID, reason string) (*orderpb.GetOrderResponse, error) {
+	order, err := s.getOwnedOrder(ctx, email, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == domain.OrderStatusCancelled {
+		return &orderpb.GetOrderResponse{Order: utils.OrderToProto(order)}, nil
+	}
+	if !domain.CanTransition(order.Status, domain.OrderStatusCancelled) {
+		return nil, cancelRejected(order.Status)
+	}
+
+	if reason == "" {
+		reason = "cancelled by customer"
+	}
+	now := time.Now().UTC()
+	event, err := utils.NewOutboxMessage(ctx, order.ID, domain.EventTypeOrderCancelled, &orderpb.OrderCancelledEvent{
+		OrderId: order.ID,
+		UserId:  order.UserID,
+		Reason:  reason,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+	change := &domain.StatusChange{
+		OrderID:   order.ID,
+		ToStatus:  domain.OrderStatusCancelled,
+		Actor:     email,
+		Reason:    reason,
+		CreatedAt: now,
+	}
+	err = s.repo.UpdateStatus(ctx, change, "", event)
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		// The order moved on since it was read; a concurrent cancel wins
+		// quietly, anything else is reported.
+		current, getErr := s.repo.GetByID(ctx, order.ID)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if current.Status == domain.OrderStatusCancelled {
+			return &orderpb.GetOrderResponse{Order: utils.OrderToProto(current)}, nil
+		}
+		return nil, cancelRejected(current.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.notifier.Publish(ctx, change)
+
+	updated, err := s.repo.GetByID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &orderpb.GetOrderResponse{Order: utils.OrderToProto(updated)}, nil
+}
+
+func cancelRejected(orderStatus string) error {
+	if orderStatus == domain.OrderStatusPaid {
+		return status.Error(codes.FailedPrecondition, "order is already paid, request a refund instead")
+	}
+	return status.Errorf(codes.FailedPrecondition, "order in status %s can no longer be cancelled", orderStatus)
+}
+
+func (s *service) updateStatus(ctx context.Context, orderID, toStatus, actor, reason, errorMessage string) error {
+	change := &domain.StatusChange{
+		OrderID:   orderID,
+		ToStatus:  toStatus,
+		Actor:     actor,
+		Reason:    reason,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.UpdateStatus(ctx, change, errorMessage); err != nil {
+		return err
+	}
+	s.notifier.Publish(ctx, change)
+	return nil
+}
+
+// getOwnedOrder loads an order and hides it from anyone but its owner.
+func (s *service) getOwnedOrder(ctx context.Context, email, orderID string) (*domain.Order, error) {
+	if email == "" {
+		return nil, errUnauthenticated
+	}
+	order, err := s.repo.GetByID(ctx, orderID)
+	if errors.Is(err, orderRepo.ErrOrderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != email {
+		return nil, status.Error(codes.NotFound, orderRepo.ErrOrderNotFound.Error())
+	}
+	return order, nil
+}
+
+// priceItems merges repeated (category, product) pairs into a single line
+// and snapshots the current unit price of every line from the product
+// service.
+func (s *service) priceItems(ctx context.Context, orderID string, reqItems []*orderpb.CreateOrderItem, now time.Time) ([]domain.OrderItem, error) {
+	type lineKey struct{ category, productID string }
+
+	items := make([]domain.OrderItem, 0, len(reqItems))
+	index := make(map[lineKey]int, len(reqItems))
+	for _, reqItem := range reqItems {
+		key := lineKey{reqItem.Category, reqItem.ProductId}
+		if i, ok := index[key]; ok {
+			items[i].Quantity += int(reqItem.Quantity)
+			continue
+		}
+		index[key] = len(items)
+		items = append(items, domain.OrderItem{
+			ID:        uuid.New().String(),
+			OrderID:   orderID,
+			ProductID: reqItem.ProductId,
+			Category:  reqItem.Category,
+			Quantity:  int(reqItem.Quantity),
+			CreatedAt: now,
+		})
+	}
+
+	for i := range items {
+		product, err := s.productClient.GetProductById(ctx, &productpb.GetProductByIdRequest{
+			Category:  items[i].Category,
+			ProductId: items[i].ProductID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("product %s: %w", items[i].ProductID, err)
+		}
+		items[i].ProductName = product.Name
+		items[i].UnitPrice = product.Price
+		items[i].LineTotal = utils.RoundAmount(product.Price * float64(items[i].Quantity))
 	}
+	return items, nil
 }
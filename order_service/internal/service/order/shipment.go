@@ -0,0 +1,131 @@
+package orderService
+
+import (
+	"context"
+	"errors"
+	"order_service/internal/domain"
+	orderRepo "order_service/internal/repo/order"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateShipment records a parcel of a paid or already shipped order. The
+// first shipment moves the order to shipped.
+func (s *service) CreateShipment(ctx context.Context, actor, role string, req *orderpb.CreateShipmentRequest) (*domain.Shipment, error) {
+	if !domain.IsAdmin(role) {
+		return nil, status.Error(codes.PermissionDenied, "only staff can create shipments")
+	}
+	order, err := s.repo.GetByID(ctx, req.OrderId)
+	if errors.Is(err, orderRepo.ErrOrderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	shipment := &domain.Shipment{
+		ID:             uuid.New().String(),
+		OrderID:        order.ID,
+		Carrier:        strings.TrimSpace(req.Carrier),
+		TrackingNumber: strings.TrimSpace(req.TrackingNumber),
+		Status:         domain.ShipmentStatusInTransit,
+		CreatedBy:      actor,
+		ShippedAt:      now,
+		UpdatedAt:      now,
+	}
+	var change *domain.StatusChange
+	var events []*domain.OutboxMessage
+	switch order.Status {
+	case domain.OrderStatusPaid:
+		change = &domain.StatusChange{
+			OrderID:   order.ID,
+			ToStatus:  domain.OrderStatusShipped,
+			Actor:     actor,
+			Reason:    "shipped with " + shipment.Carrier + " " + shipment.TrackingNumber,
+			CreatedAt: now,
+		}
+		// The first shipment takes the order's reserved stock off hand.
+		event, err := utils.NewOutboxMessage(ctx, order.ID, domain.EventTypeOrderShipped, &orderpb.OrderShippedEvent{
+			OrderId:    order.ID,
+			ShipmentId: shipment.ID,
+		}, now)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	case domain.OrderStatusShipped:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "order in status %s cannot be shipped", order.Status)
+	}
+
+	err = s.repo.CreateShipment(ctx, shipment, change, events...)
+	if errors.Is(err, orderRepo.ErrTrackingNumberInUse) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		// The order changed since it was read, e.g. it was cancelled.
+		return nil, status.Errorf(codes.FailedPrecondition, "order cannot be shipped: %v", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if change != nil {
+		s.notifier.Publish(ctx, change)
+	}
+	return shipment, nil
+}
+
+// MarkShipmentDelivered marks the shipment delivered and, once no other
+// shipment of the order is in transit, moves the order to delivered.
+// Delivering a delivered shipment returns it unchanged.
+func (s *service) MarkShipmentDelivered(ctx context.Context, actor, role, shipmentID string) (*domain.Shipment, error) {
+	if !domain.IsAdmin(role) {
+		return nil, status.Error(codes.PermissionDenied, "only staff can mark shipments delivered")
+	}
+	shipment, err := s.repo.GetShipment(ctx, shipmentID)
+	if errors.Is(err, orderRepo.ErrShipmentNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if shipment.Status == domain.ShipmentStatusDelivered {
+		return shipment, nil
+	}
+
+	now := time.Now().UTC()
+	change := &domain.StatusChange{
+		OrderID:   shipment.OrderID,
+		ToStatus:  domain.OrderStatusDelivered,
+		Actor:     actor,
+		Reason:    "all shipments delivered",
+		CreatedAt: now,
+	}
+	delivered := *shipment
+	delivered.Status = domain.ShipmentStatusDelivered
+	delivered.DeliveredAt = &now
+	delivered.UpdatedAt = now
+
+	orderDelivered, err := s.repo.DeliverShipment(ctx, &delivered, change)
+	if errors.Is(err, orderRepo.ErrShipmentNotInTransit) {
+		// Delivered concurrently.
+		return s.repo.GetShipment(ctx, shipmentID)
+	}
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		return nil, status.Errorf(codes.FailedPrecondition, "shipment cannot be delivered: %v", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if orderDelivered {
+		s.notifier.Publish(ctx, change)
+	}
+	return &delivered, nil
+}
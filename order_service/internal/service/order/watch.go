@@ -0,0 +1,114 @@
+package orderService
+
+import (
+	"context"
+	"order_service/internal/domain"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchResyncInterval bounds how long a watcher can miss a transition whose
+// pub/sub message was lost, e.g. during a Redis reconnect.
+const watchResyncInterval = 30 * time.Second
+
+// WatchOrder streams the caller's order to send: first the latest transition,
+// then every later one until the order reaches a status that ends the watch,
+// see domain.EndsWatch. Pub/sub
+// messages are checked against the status history, which stays the source of
+// truth, so updates are neither repeated nor skipped.
+func (s *service) WatchOrder(ctx context.Context, email, orderID string, send func(*orderpb.OrderStatusUpdate) error) error {
+	if _, err := s.getOwnedOrder(ctx, email, orderID); err != nil {
+		return err
+	}
+
+	// Subscribe before reading the history so no transition falls between.
+	sub, err := s.notifier.Subscribe(ctx, orderID)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer sub.Close()
+
+	w := &orderWatch{service: s, orderID: orderID, send: send}
+	if err := w.catchUp(ctx, true); err != nil || w.done {
+		return err
+	}
+
+	resync := time.NewTicker(watchResyncInterval)
+	defer resync.Stop()
+	for !w.done {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-resync.C:
+			err = w.catchUp(ctx, false)
+		case change, ok := <-sub.Changes():
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return status.Error(codes.Unavailable, "order updates unavailable")
+			}
+			err = w.apply(ctx, change)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type orderWatch struct {
+	service *service
+	orderID string
+	send    func(*orderpb.OrderStatusUpdate) error
+	// lastID and lastStatus describe the last transition sent.
+	lastID     int64
+	lastStatus string
+	done       bool
+}
+
+// apply sends change if it directly follows the last one sent, and otherwise
+// falls back to the history to fill the gap.
+func (w *orderWatch) apply(ctx context.Context, change *domain.StatusChange) error {
+	if change.ID <= w.lastID {
+		return nil
+	}
+	if change.FromStatus == w.lastStatus {
+		return w.sendChange(change)
+	}
+	return w.catchUp(ctx, false)
+}
+
+// catchUp sends the transitions recorded after the last one sent. With
+// latestOnly, only the most recent transition is sent.
+func (w *orderWatch) catchUp(ctx context.Context, latestOnly bool) error {
+	history, err := w.service.repo.GetStatusHistory(ctx, w.orderID)
+	if err != nil {
+		return err
+	}
+	if latestOnly && len(history) > 0 {
+		history = history[len(history)-1:]
+	}
+	for i := range history {
+		if history[i].ID <= w.lastID {
+			continue
+		}
+		if err := w.sendChange(&history[i]); err != nil || w.done {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *orderWatch) sendChange(change *domain.StatusChange) error {
+	if err := w.send(utils.StatusUpdateToProto(change)); err != nil {
+		return err
+	}
+	w.lastID, w.lastStatus = change.ID, change.ToStatus
+	w.done = domain.EndsWatch(change.ToStatus)
+	return nil
+}
@@ -0,0 +1,279 @@
+package paymentService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"order_service/internal/domain"
+	"order_service/internal/payment"
+	orderRepo "order_service/internal/repo/order"
+	paymentRepo "order_service/internal/repo/payment"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// refundReconcileAge is how long a refund stays pending before the
+	// reconciler takes it over from the request that created it.
+	refundReconcileAge   = 5 * time.Minute
+	refundReconcileBatch = 50
+)
+
+// RefundOrder gives back the listed items, or everything not refunded yet,
+// through the payment provider. The refund is counted against the order
+// before the provider is called and released again only if the provider
+// rejects it; a refund with an unknown outcome is left to the reconciler.
+// Refunding the last items moves the order to refunded.
+func (s *service) RefundOrder(ctx context.Context, actor, role string, req *orderpb.RefundOrderRequest) (*domain.Refund, *domain.Order, error) {
+	if !domain.IsAdmin(role) {
+		return nil, nil, status.Error(codes.PermissionDenied, "only admins can refund orders")
+	}
+
+	order, err := s.orders.GetByID(ctx, req.OrderId)
+	if errors.Is(err, orderRepo.ErrOrderNotFound) {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !domain.CanTransition(order.Status, domain.OrderStatusRefunded) || order.PaymentID == "" {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "order in status %s cannot be refunded", order.Status)
+	}
+	p, err := s.payments.GetByID(ctx, order.PaymentID)
+	if errors.Is(err, paymentRepo.ErrPaymentNotFound) {
+		return nil, nil, status.Error(codes.FailedPrecondition, "order has no captured payment")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now().UTC()
+	refund := &domain.Refund{
+		ID:        uuid.New().String(),
+		OrderID:   order.ID,
+		PaymentID: p.ID,
+		Reason:    req.Reason,
+		Actor:     actor,
+		Status:    domain.RefundStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := buildRefundItems(order, refund, req.Items); err != nil {
+		return nil, nil, err
+	}
+
+	err = s.orders.CreateRefund(ctx, refund)
+	if errors.Is(err, orderRepo.ErrRefundExceedsOrder) {
+		return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The refund id is the provider's idempotency key, so the reconciler can
+	// safely repeat the call if its outcome is unknown.
+	providerRefund, err := s.provider.Refund(ctx, p.CaptureID, refund.Amount, refund.ID)
+	if errors.Is(err, payment.ErrRefundRejected) {
+		s.failRefund(ctx, refund, err)
+		return nil, nil, fmt.Errorf("failed to refund payment: %w", err)
+	}
+	if err != nil {
+		// The provider may have refunded anyway, so the refund stays pending
+		// and keeps its amount counted until the reconciler settles it.
+		log.Printf("refund %s left pending, provider outcome unknown: %v", refund.ID, err)
+		return nil, nil, status.Error(codes.Unavailable, "refund is pending with the payment provider and will be retried")
+	}
+	if err := s.completeRefund(ctx, order, refund, providerRefund, refundsRemainder(order, refund)); err != nil {
+		return nil, nil, err
+	}
+
+	updated, err := s.orders.GetByID(ctx, order.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return refund, updated, nil
+}
+
+// RunRefundReconciler settles refunds left pending by an unknown provider
+// outcome every interval until ctx is cancelled.
+func (s *service) RunRefundReconciler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.reconcileRefunds(ctx)
+		}
+	}
+}
+
+func (s *service) reconcileRefunds(ctx context.Context) {
+	refunds, err := s.orders.ListPendingRefunds(ctx, time.Now().UTC().Add(-refundReconcileAge), refundReconcileBatch)
+	if err != nil {
+		log.Printf("failed to list pending refunds: %v", err)
+		return
+	}
+	for _, refund := range refunds {
+		if err := s.reconcileRefund(ctx, refund); err != nil {
+			log.Printf("failed to reconcile refund %s: %v", refund.ID, err)
+		}
+	}
+}
+
+// reconcileRefund repeats the provider call of a pending refund under the
+// same idempotency key, which returns the first refund if it went through.
+func (s *service) reconcileRefund(ctx context.Context, refund *domain.Refund) error {
+	p, err := s.payments.GetByID(ctx, refund.PaymentID)
+	if err != nil {
+		return err
+	}
+	providerRefund, err := s.provider.Refund(ctx, p.CaptureID, refund.Amount, refund.ID)
+	if errors.Is(err, payment.ErrRefundRejected) {
+		s.failRefund(ctx, refund, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	order, err := s.orders.GetByID(ctx, refund.OrderID)
+	if err != nil {
+		return err
+	}
+	// The order's refunded quantities already include this refund.
+	return s.completeRefund(ctx, order, refund, providerRefund, refundsRemainder(order, &domain.Refund{}))
+}
+
+// completeRefund records the provider's refund and, when toRefunded is set,
+// moves the order to refunded.
+func (s *service) completeRefund(ctx context.Context, order *domain.Order, refund *domain.Refund, providerRefund *payment.Refund, toRefunded bool) error {
+	refund.ProviderRefundID = providerRefund.ID
+	refund.Status = domain.RefundStatusCompleted
+	refund.UpdatedAt = time.Now().UTC()
+
+	event, err := utils.NewOutboxMessage(ctx, order.ID, domain.EventTypeOrderRefunded, &orderpb.OrderRefundedEvent{
+		OrderId:  order.ID,
+		RefundId: refund.ID,
+		Items:    utils.RefundItemsToEvent(refund.Items),
+	}, refund.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	var change *domain.StatusChange
+	if toRefunded {
+		change = &domain.StatusChange{
+			OrderID:   order.ID,
+			ToStatus:  domain.OrderStatusRefunded,
+			Actor:     refund.Actor,
+			Reason:    refund.Reason,
+			CreatedAt: refund.UpdatedAt,
+		}
+	}
+	err = s.orders.CompleteRefund(ctx, refund, change, event)
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		// The money is back with the customer either way; only the status
+		// change is dropped if the order moved on meanwhile.
+		log.Printf("refund %s completed but order %s not moved to refunded: %v", refund.ID, order.ID, err)
+		change = nil
+		err = s.orders.CompleteRefund(ctx, refund, nil, event)
+	}
+	if err != nil {
+		return err
+	}
+	if change != nil {
+		s.notifier.Publish(ctx, change)
+	}
+	return nil
+}
+
+// failRefund releases a refund the provider rejected.
+func (s *service) failRefund(ctx context.Context, refund *domain.Refund, cause error) {
+	refund.Status = domain.RefundStatusFailed
+	refund.FailureReason = cause.Error()
+	refund.UpdatedAt = time.Now().UTC()
+	if err := s.orders.FailRefund(ctx, refund); err != nil {
+		log.Printf("refund %s was rejected by the provider and could not be released: %v", refund.ID, err)
+	}
+}
+
+// buildRefundItems resolves the requested products to order items and prices
+// them at the price the customer paid.
+func buildRefundItems(order *domain.Order, refund *domain.Refund, requested []*orderpb.RefundItem) error {
+	quantities := make(map[string]int, len(order.Items))
+	if len(requested) == 0 {
+		for _, item := range order.Items {
+			quantities[item.ProductID] = item.Quantity - item.RefundedQuantity
+		}
+	}
+	for _, r := range requested {
+		quantities[r.ProductId] += int(r.Quantity)
+	}
+
+	for productID := range quantities {
+		if findItem(order, productID) == nil {
+			return status.Errorf(codes.InvalidArgument, "product %s is not part of the order", productID)
+		}
+	}
+
+	for _, item := range order.Items {
+		qty := quantities[item.ProductID]
+		if qty == 0 {
+			continue
+		}
+		if remaining := item.Quantity - item.RefundedQuantity; qty > remaining {
+			return status.Errorf(codes.FailedPrecondition, "only %d units of %s are left to refund", remaining, item.ProductID)
+		}
+		amount := utils.RoundAmount(item.UnitPrice * float64(qty))
+		refund.Items = append(refund.Items, domain.RefundItem{
+			RefundID:    refund.ID,
+			OrderItemID: item.ID,
+			ProductID:   item.ProductID,
+			Category:    item.Category,
+			Quantity:    qty,
+			Amount:      amount,
+		})
+		refund.Amount += amount
+	}
+	if len(refund.Items) == 0 {
+		return status.Error(codes.FailedPrecondition, "nothing left to refund on the order")
+	}
+
+	// The last refund gives back exactly what is left so rounding of line
+	// amounts never leaves cents behind or overshoots the charge.
+	if refundsRemainder(order, refund) {
+		refund.Amount = order.TotalAmount - order.RefundedAmount
+	}
+	refund.Amount = utils.RoundAmount(refund.Amount)
+	return nil
+}
+
+// refundsRemainder reports whether refund covers every unit not yet refunded.
+func refundsRemainder(order *domain.Order, refund *domain.Refund) bool {
+	refunded := make(map[string]int, len(refund.Items))
+	for _, item := range refund.Items {
+		refunded[item.OrderItemID] = item.Quantity
+	}
+	for _, item := range order.Items {
+		if item.RefundedQuantity+refunded[item.ID] < item.Quantity {
+			return false
+		}
+	}
+	return true
+}
+
+func findItem(order *domain.Order, productID string) *domain.OrderItem {
+	for i := range order.Items {
+		if order.Items[i].ProductID == productID {
+			return &order.Items[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package paymentService
+
+import (
+	"order_service/internal/domain"
+	orderpb "order_service/proto/gen"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func refundTestOrder() *domain.Order {
+	return &domain.Order{
+		ID:          "order-1",
+		TotalAmount: 10.01,
+		Items: []domain.OrderItem{
+			{ID: "item-a", ProductID: "a", Category: "books", Quantity: 3, UnitPrice: 1.11},
+			{ID: "item-b", ProductID: "b", Category: "games", Quantity: 1, UnitPrice: 6.67},
+		},
+	}
+}
+
+func TestBuildRefundItemsPartial(t *testing.T) {
+	order := refundTestOrder()
+	refund := &domain.Refund{ID: "refund-1"}
+
+	err := buildRefundItems(order, refund, []*orderpb.RefundItem{{ProductId: "a", Quantity: 2}})
+	if err != nil {
+		t.Fatalf("buildRefundItems: %v", err)
+	}
+	if len(refund.Items) != 1 || refund.Items[0].OrderItemID != "item-a" || refund.Items[0].Quantity != 2 {
+		t.Fatalf("unexpected items %+v", refund.Items)
+	}
+	if refund.Amount != 2.22 {
+		t.Errorf("amount = %v, want 2.22", refund.Amount)
+	}
+	if refundsRemainder(order, refund) {
+		t.Error("partial refund reported as remainder")
+	}
+}
+
+func TestBuildRefundItemsRemainderTakesWhatIsLeft(t *testing.T) {
+	order := refundTestOrder()
+	order.Items[0].RefundedQuantity = 2
+	order.RefundedAmount = 2.22
+	refund := &domain.Refund{ID: "refund-2"}
+
+	if err := buildRefundItems(order, refund, nil); err != nil {
+		t.Fatalf("buildRefundItems: %v", err)
+	}
+	if !refundsRemainder(order, refund) {
+		t.Fatal("full refund not reported as remainder")
+	}
+	// The last refund gives back what is left of the charge, not the sum of
+	// its lines.
+	if refund.Amount != 7.79 {
+		t.Errorf("amount = %v, want 7.79", refund.Amount)
+	}
+}
+
+func TestBuildRefundItemsRejectsTooMuch(t *testing.T) {
+	order := refundTestOrder()
+	order.Items[1].RefundedQuantity = 1
+
+	tests := []struct {
+		name  string
+		items []*orderpb.RefundItem
+		code  codes.Code
+	}{
+		{"unknown product", []*orderpb.RefundItem{{ProductId: "c", Quantity: 1}}, codes.InvalidArgument},
+		{"more than left", []*orderpb.RefundItem{{ProductId: "b", Quantity: 1}}, codes.FailedPrecondition},
+		{"repeated product", []*orderpb.RefundItem{{ProductId: "a", Quantity: 2}, {ProductId: "a", Quantity: 2}}, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := buildRefundItems(order, &domain.Refund{}, tt.items)
+			if status.Code(err) != tt.code {
+				t.Errorf("code = %v, want %v (%v)", status.Code(err), tt.code, err)
+			}
+		})
+	}
+}
+
+func TestRefundsRemainderCountsRefundedQuantities(t *testing.T) {
+	order := refundTestOrder()
+	order.Items[0].RefundedQuantity = 3
+	order.Items[1].RefundedQuantity = 1
+
+	// The reconciler checks an order whose quantities already include the
+	// pending refund.
+	if !refundsRemainder(order, &domain.Refund{}) {
+		t.Error("fully refunded order not reported as remainder")
+	}
+}
@@ -0,0 +1,330 @@
+package paymentService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"order_service/internal/domain"
+	"order_service/internal/payment"
+	"order_service/internal/pubsub"
+	orderRepo "order_service/internal/repo/order"
+	paymentRepo "order_service/internal/repo/payment"
+	"order_service/internal/utils"
+	orderpb "order_service/proto/gen"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type service struct {
+	orders   orderRepo.Repo
+	payments paymentRepo.Repo
+	provider payment.PaymentProvider
+	notifier pubsub.StatusNotifier
+	currency string
+}
+
+type Service interface {
+	InitiatePayment(ctx context.Context, email, orderID string) (*domain.Payment, error)
+	ConfirmPayment(ctx context.Context, email, paymentID, paymentMethod string) (*domain.Payment, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+	RefundOrder(ctx context.Context, actor, role string, req *orderpb.RefundOrderRequest) (*domain.Refund, *domain.Order, error)
+	RunRefundReconciler(ctx context.Context, interval time.Duration)
+}
+
+func NewService(orders orderRepo.Repo, payments paymentRepo.Repo, provider payment.PaymentProvider, notifier pubsub.StatusNotifier, currency string) Service {
+	return &service{
+		orders:   orders,
+		payments: payments,
+		provider: provider,
+		notifier: notifier,
+		currency: currency,
+	}
+}
+
+// InitiatePayment opens a payment for the caller's validated order. An open
+// payment that was not settled yet is returned instead of a new one.
+func (s *service) InitiatePayment(ctx context.Context, email, orderID string) (*domain.Payment, error) {
+	order, err := s.getOwnedOrder(ctx, email, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPayable(order.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "order in status %s cannot be paid", order.Status)
+	}
+
+	open, err := s.payments.GetOpenByOrder(ctx, order.ID)
+	if err == nil {
+		return open, nil
+	}
+	if !errors.Is(err, paymentRepo.ErrPaymentNotFound) {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	p := &domain.Payment{
+		ID:        uuid.New().String(),
+		OrderID:   order.ID,
+		Provider:  s.provider.Name(),
+		Amount:    order.TotalAmount,
+		Currency:  s.currency,
+		Status:    domain.PaymentStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.payments.Create(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ConfirmPayment authorizes a pending payment with the provider. The order
+// becomes paid once the provider's callback reports the authorization
+// succeeded; a declined authorization fails the payment straight away.
+// Confirmations of one order are serialized, so concurrent calls cannot
+// authorize it twice.
+func (s *service) ConfirmPayment(ctx context.Context, email, paymentID, paymentMethod string) (*domain.Payment, error) {
+	p, err := s.payments.GetByID(ctx, paymentID)
+	if errors.Is(err, paymentRepo.ErrPaymentNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getOwnedOrder(ctx, email, p.OrderID); err != nil {
+		return nil, err
+	}
+
+	err = s.payments.WithOrderLock(ctx, p.OrderID, func() error {
+		// Re-read under the lock: a concurrent call may have moved it on.
+		if p, err = s.payments.GetByID(ctx, paymentID); err != nil {
+			return err
+		}
+		return s.authorize(ctx, p, paymentMethod)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// authorize runs the provider authorization of a pending payment and stores
+// its outcome in p. The caller holds the order lock.
+func (s *service) authorize(ctx context.Context, p *domain.Payment, paymentMethod string) error {
+	switch p.Status {
+	case domain.PaymentStatusAuthorized, domain.PaymentStatusCaptured:
+		return nil
+	case domain.PaymentStatusFailed, domain.PaymentStatusVoided:
+		return status.Error(codes.FailedPrecondition, "payment has failed, initiate a new payment")
+	}
+	open, err := s.payments.GetOpenByOrder(ctx, p.OrderID)
+	if err != nil && !errors.Is(err, paymentRepo.ErrPaymentNotFound) {
+		return err
+	}
+	if err == nil && open.ID != p.ID && open.Status == domain.PaymentStatusAuthorized {
+		return status.Error(codes.FailedPrecondition, "another payment of the order is already authorized")
+	}
+
+	auth, err := s.provider.Authorize(ctx, &payment.AuthorizeRequest{
+		PaymentID:     p.ID,
+		OrderID:       p.OrderID,
+		Amount:        p.Amount,
+		Currency:      p.Currency,
+		PaymentMethod: paymentMethod,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to authorize payment: %w", err)
+	}
+
+	p.AuthorizationID = auth.ID
+	if auth.Status == payment.AuthorizationDeclined {
+		return s.fail(ctx, p, auth.DeclineReason)
+	}
+
+	p.Status = domain.PaymentStatusAuthorized
+	p.UpdatedAt = time.Now().UTC()
+	return s.payments.Update(ctx, p)
+}
+
+// HandleWebhook applies a provider callback. Callbacks are retried by the
+// provider until this returns nil, so every outcome is idempotent.
+func (s *service) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	event, err := s.provider.VerifyWebhook(payload, signature)
+	if errors.Is(err, payment.ErrInvalidSignature) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.payments.GetByAuthorizationID(ctx, s.provider.Name(), event.AuthorizationID)
+	if errors.Is(err, paymentRepo.ErrPaymentNotFound) {
+		// The callback can overtake ConfirmPayment storing the
+		// authorization; failing makes the provider deliver it again.
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.payments.WithOrderLock(ctx, p.OrderID, func() error {
+		if p, err = s.payments.GetByID(ctx, p.ID); err != nil {
+			return err
+		}
+		switch event.Type {
+		case payment.WebhookAuthorizationSucceeded:
+			return s.settle(ctx, p)
+		case payment.WebhookAuthorizationFailed:
+			return s.fail(ctx, p, event.FailureReason)
+		default:
+			log.Printf("payment: ignoring webhook %s of type %s", event.ID, event.Type)
+			return nil
+		}
+	})
+}
+
+// settle captures an authorized payment and marks its order paid.
+func (s *service) settle(ctx context.Context, p *domain.Payment) error {
+	if p.Status == domain.PaymentStatusCaptured {
+		return s.markPaid(ctx, p)
+	}
+	if p.Status != domain.PaymentStatusAuthorized {
+		return nil
+	}
+
+	order, err := s.orders.GetByID(ctx, p.OrderID)
+	if err != nil {
+		return err
+	}
+	if !isPayable(order.Status) {
+		// Cancelled while the authorization was in flight.
+		return s.void(ctx, p, fmt.Sprintf("order is %s", order.Status))
+	}
+
+	capture, err := s.provider.Capture(ctx, p.AuthorizationID, p.Amount)
+	if err != nil {
+		return fmt.Errorf("failed to capture payment: %w", err)
+	}
+	p.CaptureID = capture.ID
+	p.Status = domain.PaymentStatusCaptured
+	p.UpdatedAt = time.Now().UTC()
+	if err := s.payments.Update(ctx, p); err != nil {
+		return err
+	}
+	return s.markPaid(ctx, p)
+}
+
+// markPaid moves the order of a captured payment to paid and tells inventory
+// to keep its reserved stock. If the order was cancelled after the capture
+// check, the capture is refunded instead.
+func (s *service) markPaid(ctx context.Context, p *domain.Payment) error {
+	now := time.Now().UTC()
+	change := &domain.StatusChange{
+		OrderID:   p.OrderID,
+		ToStatus:  domain.OrderStatusPaid,
+		Actor:     domain.ActorSystem,
+		Reason:    "payment captured",
+		CreatedAt: now,
+	}
+	event, err := utils.NewOutboxMessage(ctx, p.OrderID, domain.EventTypeOrderPaid, &orderpb.OrderPaidEvent{
+		OrderId:   p.OrderID,
+		PaymentId: p.ID,
+	}, now)
+	if err != nil {
+		return err
+	}
+	err = s.orders.MarkPaid(ctx, change, p.ID, event)
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		order, getErr := s.orders.GetByID(ctx, p.OrderID)
+		if getErr != nil {
+			return getErr
+		}
+		if order.PaymentID == p.ID {
+			// A redelivered callback for a payment already applied.
+			return nil
+		}
+		return s.void(ctx, p, fmt.Sprintf("order is %s", order.Status))
+	}
+	if err != nil {
+		return err
+	}
+	s.notifier.Publish(ctx, change)
+	return nil
+}
+
+// void gives the money of a payment back to the customer because its order
+// can no longer be paid: a capture is refunded, an authorization released.
+// The refund uses the payment id as idempotency key, so retries are safe.
+func (s *service) void(ctx context.Context, p *domain.Payment, reason string) error {
+	switch p.Status {
+	case domain.PaymentStatusCaptured:
+		if _, err := s.provider.Refund(ctx, p.CaptureID, p.Amount, p.ID); err != nil {
+			return fmt.Errorf("failed to refund payment of unpayable order: %w", err)
+		}
+	case domain.PaymentStatusAuthorized:
+		if err := s.provider.Void(ctx, p.AuthorizationID); err != nil {
+			return fmt.Errorf("failed to void payment of unpayable order: %w", err)
+		}
+	default:
+		return nil
+	}
+	log.Printf("payment %s voided: %s", p.ID, reason)
+	p.Status = domain.PaymentStatusVoided
+	p.FailureReason = reason
+	p.UpdatedAt = time.Now().UTC()
+	return s.payments.Update(ctx, p)
+}
+
+// fail marks the payment failed and moves its order to payment_failed.
+func (s *service) fail(ctx context.Context, p *domain.Payment, reason string) error {
+	if p.Status == domain.PaymentStatusCaptured || p.Status == domain.PaymentStatusFailed || p.Status == domain.PaymentStatusVoided {
+		return nil
+	}
+	if reason == "" {
+		reason = "payment failed"
+	}
+	p.Status = domain.PaymentStatusFailed
+	p.FailureReason = reason
+	p.UpdatedAt = time.Now().UTC()
+	if err := s.payments.Update(ctx, p); err != nil {
+		return err
+	}
+
+	change := &domain.StatusChange{
+		OrderID:   p.OrderID,
+		ToStatus:  domain.OrderStatusPaymentFailed,
+		Actor:     domain.ActorSystem,
+		Reason:    reason,
+		CreatedAt: p.UpdatedAt,
+	}
+	err := s.orders.UpdateStatus(ctx, change, reason)
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	s.notifier.Publish(ctx, change)
+	return nil
+}
+
+func (s *service) getOwnedOrder(ctx context.Context, email, orderID string) (*domain.Order, error) {
+	order, err := s.orders.GetByID(ctx, orderID)
+	if errors.Is(err, orderRepo.ErrOrderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != email {
+		return nil, status.Error(codes.NotFound, orderRepo.ErrOrderNotFound.Error())
+	}
+	return order, nil
+}
+
+func isPayable(orderStatus string) bool {
+	return orderStatus == domain.OrderStatusValidated || orderStatus == domain.OrderStatusPaymentFailed
+}
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"order_service/internal/kafka"
+	orderpb "order_service/proto/gen"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func DeadLetterToProto(letter *kafka.DeadLetter) *orderpb.DeadLetter {
+	return &orderpb.DeadLetter{
+		Partition:         int32(letter.Partition),
+		Offset:            letter.Offset,
+		Key:               letter.Key,
+		EventType:         letter.EventType,
+		OriginalTopic:     letter.OriginalTopic,
+		OriginalPartition: int32(letter.OriginalPartition),
+		OriginalOffset:    letter.OriginalOffset,
+		Error:             letter.Error,
+		Attempts:          int32(letter.Attempts),
+		Payload:           letter.Payload,
+		FailedAt:          timestamppb.New(letter.FailedAt),
+	}
+}
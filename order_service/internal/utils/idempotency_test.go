@@ -0,0 +1,37 @@
+package utils
+
+import (
+	orderpb "order_service/proto/gen"
+	"testing"
+)
+
+func TestRequestHashTellsRetriesFromOtherRequests(t *testing.T) {
+	request := func(quantity int32) *orderpb.CreateOrderRequest {
+		return &orderpb.CreateOrderRequest{
+			Items: []*orderpb.CreateOrderItem{
+				{ProductId: "p1", Category: "books", Quantity: quantity},
+				{ProductId: "p2", Category: "games", Quantity: 1},
+			},
+		}
+	}
+
+	first, err := RequestHash(request(2))
+	if err != nil {
+		t.Fatalf("RequestHash: %v", err)
+	}
+	retry, err := RequestHash(request(2))
+	if err != nil {
+		t.Fatalf("RequestHash: %v", err)
+	}
+	if first != retry {
+		t.Errorf("retry hashed to %s, want %s", retry, first)
+	}
+
+	other, err := RequestHash(request(3))
+	if err != nil {
+		t.Fatalf("RequestHash: %v", err)
+	}
+	if other == first {
+		t.Error("different request hashed like the first one")
+	}
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"order_service/internal/domain"
+	"order_service/internal/invoice"
+	orderpb "order_service/proto/gen"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func InvoiceToProto(inv *domain.Invoice, seller invoice.Seller) *orderpb.Invoice {
+	lines := make([]*orderpb.InvoiceLine, 0, len(inv.Items))
+	for _, item := range inv.Items {
+		lines = append(lines, &orderpb.InvoiceLine{
+			ProductId:        item.ProductID,
+			ProductName:      item.ProductName,
+			Quantity:         int32(item.Quantity),
+			UnitPrice:        item.UnitPrice,
+			LineTotal:        item.LineTotal,
+			RefundedQuantity: int32(item.RefundedQuantity),
+		})
+	}
+	return &orderpb.Invoice{
+		InvoiceNumber: inv.Number,
+		OrderId:       inv.OrderID,
+		IssuedAt:      timestamppb.New(inv.IssuedAt),
+		Seller: &orderpb.InvoiceParty{
+			Name:    seller.Name,
+			Address: seller.Address,
+		},
+		Buyer: &orderpb.InvoiceParty{
+			Name:    inv.BuyerName,
+			Email:   inv.BuyerEmail,
+			Phone:   inv.BuyerPhone,
+			Address: inv.BuyerAddress,
+		},
+		Currency:       inv.Currency,
+		Lines:          lines,
+		Subtotal:       inv.Subtotal,
+		TaxRate:        inv.TaxRate,
+		TaxAmount:      inv.TaxAmount,
+		Total:          inv.Total,
+		RefundedAmount: inv.RefundedAmount,
+		AmountPaid:     RoundAmount(inv.Total - inv.RefundedAmount),
+	}
+}
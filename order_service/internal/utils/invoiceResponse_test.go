@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"order_service/internal/domain"
+	"order_service/internal/invoice"
+	"testing"
+)
+
+func TestInvoiceToProtoShowsRefunds(t *testing.T) {
+	inv := &domain.Invoice{
+		Number: "INV-1",
+		Total:  30.00,
+		Items: []domain.OrderItem{
+			{ProductID: "a", Quantity: 2, UnitPrice: 10, LineTotal: 20, RefundedQuantity: 1},
+			{ProductID: "b", Quantity: 1, UnitPrice: 10, LineTotal: 10},
+		},
+		RefundedAmount: 10.00,
+	}
+
+	got := InvoiceToProto(inv, invoice.Seller{Name: "Shop"})
+	if got.Total != 30 || got.RefundedAmount != 10 || got.AmountPaid != 20 {
+		t.Errorf("total %v, refunded %v, paid %v; want 30, 10, 20", got.Total, got.RefundedAmount, got.AmountPaid)
+	}
+	if got.Lines[0].RefundedQuantity != 1 || got.Lines[1].RefundedQuantity != 0 {
+		t.Errorf("refunded quantities %d and %d, want 1 and 0", got.Lines[0].RefundedQuantity, got.Lines[1].RefundedQuantity)
+	}
+}
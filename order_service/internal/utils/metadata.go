@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GetUserEmail returns the caller email injected by the gateway.
+func GetUserEmail(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing authentication metadata")
+	}
+	emails := md.Get("x-user-email")
+	if len(emails) == 0 || emails[0] == "" {
+		return "", status.Error(codes.Unauthenticated, "user email not found in metadata")
+	}
+	return emails[0], nil
+}
+
+// MaxIdempotencyKeyLength bounds the idempotency-key metadata value.
+const MaxIdempotencyKeyLength = 255
+
+// GetIdempotencyKey returns the client's idempotency-key, or an empty string
+// when the request did not send one.
+func GetIdempotencyKey(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	keys := md.Get("idempotency-key")
+	if len(keys) == 0 {
+		return "", nil
+	}
+	if len(keys[0]) > MaxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "idempotency-key must be at most %d characters", MaxIdempotencyKeyLength)
+	}
+	return keys[0], nil
+}
+
+// GetCorrelationID returns the x-correlation-id set by the gateway or the
+// caller, or an empty string when it is absent.
+func GetCorrelationID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	ids := md.Get("x-correlation-id")
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// GetUserRole returns the caller role injected by the gateway, or an empty
+// string when it is absent.
+func GetUserRole(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	roles := md.Get("x-user-role")
+	if len(roles) == 0 {
+		return ""
+	}
+	return roles[0]
+}
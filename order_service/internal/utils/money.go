@@ -0,0 +1,9 @@
+package utils
+
+import "math"
+
+// RoundAmount rounds a monetary value to two decimal places, matching the
+// NUMERIC(10,2) columns amounts are stored in.
+func RoundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
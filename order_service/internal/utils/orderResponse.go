@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"order_service/internal/domain"
+	orderpb "order_service/proto/gen"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func OrderItemsToProto(items []domain.OrderItem) []*orderpb.OrderLineItem {
+	pbItems := make([]*orderpb.OrderLineItem, 0, len(items))
+	for _, item := range items {
+		pbItems = append(pbItems, &orderpb.OrderLineItem{
+			ProductId:        item.ProductID,
+			Category:         item.Category,
+			ProductName:      item.ProductName,
+			Quantity:         int32(item.Quantity),
+			UnitPrice:        item.UnitPrice,
+			LineTotal:        item.LineTotal,
+			RefundedQuantity: int32(item.RefundedQuantity),
+		})
+	}
+	return pbItems
+}
+
+func OrderItemsToEvent(items []domain.OrderItem) []*orderpb.OrderItem {
+	eventItems := make([]*orderpb.OrderItem, 0, len(items))
+	for _, item := range items {
+		eventItems = append(eventItems, &orderpb.OrderItem{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+			Category:  item.Category,
+		})
+	}
+	return eventItems
+}
+
+func OrderToProto(order *domain.Order) *orderpb.Order {
+	return &orderpb.Order{
+		OrderId:         order.ID,
+		UserId:          order.UserID,
+		Status:          order.Status,
+		TotalAmount:     order.TotalAmount,
+		ChargedAmount:   order.ChargedAmount(),
+		RefundedAmount:  order.RefundedAmount,
+		PaymentId:       order.PaymentID,
+		ErrorMessage:    order.ErrorMessage,
+		Items:           OrderItemsToProto(order.Items),
+		ShippingAddress: AddressToProto(order.ShippingAddress),
+		Shipments:       ShipmentsToProto(order.Shipments),
+		CreatedAt:       timestamppb.New(order.CreatedAt),
+		UpdatedAt:       timestamppb.New(order.UpdatedAt),
+	}
+}
+
+func StatusHistoryToProto(history []domain.StatusChange) []*orderpb.OrderStatusChange {
+	pbHistory := make([]*orderpb.OrderStatusChange, 0, len(history))
+	for i := range history {
+		pbHistory = append(pbHistory, StatusChangeToProto(&history[i]))
+	}
+	return pbHistory
+}
+
+func StatusChangeToProto(change *domain.StatusChange) *orderpb.OrderStatusChange {
+	return &orderpb.OrderStatusChange{
+		FromStatus: change.FromStatus,
+		ToStatus:   change.ToStatus,
+		Actor:      change.Actor,
+		Reason:     change.Reason,
+		ChangedAt:  timestamppb.New(change.CreatedAt),
+	}
+}
+
+// StatusUpdateToProto builds a WatchOrder message for the transition change.
+func StatusUpdateToProto(change *domain.StatusChange) *orderpb.OrderStatusUpdate {
+	return &orderpb.OrderStatusUpdate{
+		OrderId:  change.OrderID,
+		Status:   change.ToStatus,
+		Change:   StatusChangeToProto(change),
+		Terminal: domain.EndsWatch(change.ToStatus),
+	}
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"context"
+	"order_service/internal/domain"
+	"order_service/internal/kafka"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// NewOutboxMessage seals an event in its envelope, keyed by its order so all
+// events of one order land on the same partition in order. The envelope is
+// built here rather than by the relay so its event id survives retries.
+func NewOutboxMessage(ctx context.Context, orderID, eventType string, event proto.Message, now time.Time) (*domain.OutboxMessage, error) {
+	correlationID := GetCorrelationID(ctx)
+	if correlationID == "" {
+		correlationID = orderID
+	}
+	payload, err := kafka.Seal(eventType, correlationID, event, now)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.OutboxMessage{
+		AggregateID: orderID,
+		EventType:   eventType,
+		EventKey:    orderID,
+		Payload:     payload,
+		Envelope:    kafka.EnvelopeVersion,
+		CreatedAt:   now,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"order_service/internal/domain"
+	orderpb "order_service/proto/gen"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PaymentToProto(p *domain.Payment) *orderpb.Payment {
+	return &orderpb.Payment{
+		PaymentId:     p.ID,
+		OrderId:       p.OrderID,
+		Provider:      p.Provider,
+		Status:        p.Status,
+		Amount:        p.Amount,
+		Currency:      p.Currency,
+		FailureReason: p.FailureReason,
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+	}
+}
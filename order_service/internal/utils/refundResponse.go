@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"order_service/internal/domain"
+	orderpb "order_service/proto/gen"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func RefundToProto(r *domain.Refund) *orderpb.Refund {
+	items := make([]*orderpb.RefundLine, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, &orderpb.RefundLine{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+			Amount:    item.Amount,
+		})
+	}
+	return &orderpb.Refund{
+		RefundId:  r.ID,
+		OrderId:   r.OrderID,
+		Amount:    r.Amount,
+		Reason:    r.Reason,
+		Actor:     r.Actor,
+		Status:    r.Status,
+		Items:     items,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+}
+
+func RefundItemsToEvent(items []domain.RefundItem) []*orderpb.OrderItem {
+	eventItems := make([]*orderpb.OrderItem, 0, len(items))
+	for _, item := range items {
+		eventItems = append(eventItems, &orderpb.OrderItem{
+			ProductId: item.ProductID,
+			Category:  item.Category,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	return eventItems
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"order_service/internal/domain"
+	orderpb "order_service/proto/gen"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddressFromProto normalizes the country code to upper case.
+func AddressFromProto(a *orderpb.ShippingAddress) *domain.Address {
+	if a == nil {
+		return nil
+	}
+	return &domain.Address{
+		RecipientName: strings.TrimSpace(a.RecipientName),
+		Line1:         strings.TrimSpace(a.Line1),
+		Line2:         strings.TrimSpace(a.Line2),
+		City:          strings.TrimSpace(a.City),
+		State:         strings.TrimSpace(a.State),
+		PostalCode:    strings.TrimSpace(a.PostalCode),
+		Country:       strings.ToUpper(a.Country),
+		Phone:         strings.TrimSpace(a.Phone),
+	}
+}
+
+func AddressToProto(a *domain.Address) *orderpb.ShippingAddress {
+	if a == nil {
+		return nil
+	}
+	return &orderpb.ShippingAddress{
+		RecipientName: a.RecipientName,
+		Line1:         a.Line1,
+		Line2:         a.Line2,
+		City:          a.City,
+		State:         a.State,
+		PostalCode:    a.PostalCode,
+		Country:       a.Country,
+		Phone:         a.Phone,
+	}
+}
+
+func ShipmentToProto(s *domain.Shipment) *orderpb.Shipment {
+	pb := &orderpb.Shipment{
+		ShipmentId:     s.ID,
+		OrderId:        s.OrderID,
+		Carrier:        s.Carrier,
+		TrackingNumber: s.TrackingNumber,
+		Status:         s.Status,
+		CreatedBy:      s.CreatedBy,
+		ShippedAt:      timestamppb.New(s.ShippedAt),
+	}
+	if s.DeliveredAt != nil {
+		pb.DeliveredAt = timestamppb.New(*s.DeliveredAt)
+	}
+	return pb
+}
+
+func ShipmentsToProto(shipments []domain.Shipment) []*orderpb.Shipment {
+	pbShipments := make([]*orderpb.Shipment, 0, len(shipments))
+	for i := range shipments {
+		pbShipments = append(pbShipments, ShipmentToProto(&shipments[i]))
+	}
+	return pbShipments
+}
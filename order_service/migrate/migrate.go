@@ -0,0 +1,327 @@
+// Package migrate applies the SQL migrations embedded from migrate/migration.
+// Files keep the sql-migrate layout ("-- +migrate Up" / "-- +migrate Down"
+// sections) and are applied in file name order, each in its own transaction.
+// Applied versions are recorded in the schema_version table.
+package migrate
+
+import (
+	"bufio"
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+//go:embed migration/*.sql
+var migrationFiles embed.FS
+
+// lockID serializes migrations across service instances starting together.
+const lockID = 7319220051
+
+type Migration struct {
+	Version string
+	Up      string
+	Down    string
+}
+
+// Status reports whether a migration has been applied, and when.
+type Status struct {
+	Version   string
+	AppliedAt *time.Time
+}
+
+// Load returns the embedded migrations ordered by version.
+func Load() ([]Migration, error) {
+	names, err := fs.Glob(migrationFiles, "migration/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	migrations := make([]Migration, 0, len(names))
+	for _, name := range names {
+		data, err := migrationFiles.ReadFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+		m, err := parse(strings.TrimPrefix(name, "migration/"), string(data))
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, nil
+}
+
+// parse splits a migration file into its up and down sections.
+func parse(version, content string) (Migration, error) {
+	m := Migration{Version: version}
+	var up, down strings.Builder
+	var section *strings.Builder
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if directive, ok := strings.CutPrefix(strings.TrimSpace(line), "-- +migrate "); ok {
+			switch strings.Fields(directive)[0] {
+			case "Up":
+				section = &up
+			case "Down":
+				section = &down
+			}
+			// StatementBegin/End only matter to sql-migrate's own splitter;
+			// each section is sent to Postgres as one script.
+			continue
+		}
+		if section != nil {
+			section.WriteString(line)
+			section.WriteByte('\n')
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return m, fmt.Errorf("failed to parse migration %s: %w", version, err)
+	}
+
+	m.Up, m.Down = strings.TrimSpace(up.String()), strings.TrimSpace(down.String())
+	if m.Up == "" {
+		return m, fmt.Errorf("migration %s has no up section", version)
+	}
+	return m, nil
+}
+
+// Up applies every pending migration and returns how many ran.
+func Up(ctx context.Context, db *sqlx.DB) (int, error) {
+	migrations, err := Load()
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	err = withLock(ctx, db, func(conn *sqlx.Conn) error {
+		done, err := appliedVersions(ctx, conn, "schema_version")
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			if _, ok := done[m.Version]; ok {
+				continue
+			}
+			if err := apply(ctx, conn, m.Version, m.Up, true); err != nil {
+				return err
+			}
+			log.Printf("migration %s applied", m.Version)
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the latest steps applied migrations, newest first, and
+// returns how many were rolled back.
+func Down(ctx context.Context, db *sqlx.DB, steps int) (int, error) {
+	migrations, err := Load()
+	if err != nil {
+		return 0, err
+	}
+
+	rolledBack := 0
+	err = withLock(ctx, db, func(conn *sqlx.Conn) error {
+		done, err := appliedVersions(ctx, conn, "schema_version")
+		if err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0 && rolledBack < steps; i-- {
+			m := migrations[i]
+			if _, ok := done[m.Version]; !ok {
+				continue
+			}
+			if m.Down == "" {
+				return fmt.Errorf("migration %s cannot be rolled back: it has no down section", m.Version)
+			}
+			if err := apply(ctx, conn, m.Version, m.Down, false); err != nil {
+				return err
+			}
+			log.Printf("migration %s rolled back", m.Version)
+			rolledBack++
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// CurrentStatus lists every embedded migration with its applied time, plus
+// versions recorded in the database that this build does not know. It only
+// reads, so it neither waits for a running migration nor creates
+// schema_version; a database not migrated by this package yet reports the
+// history the sql-migrate CLI left in gorp_migrations.
+func CurrentStatus(ctx context.Context, db *sqlx.DB) ([]Status, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	done, err := recordedVersions(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []Status
+	for _, m := range migrations {
+		s := Status{Version: m.Version}
+		if at, ok := done[m.Version]; ok {
+			s.AppliedAt = &at
+			delete(done, m.Version)
+		}
+		statuses = append(statuses, s)
+	}
+	for version, at := range done {
+		statuses = append(statuses, Status{Version: version + " (unknown)", AppliedAt: &at})
+	}
+	return statuses, nil
+}
+
+// recordedVersions reads the applied versions from schema_version, or from
+// gorp_migrations while schema_version does not exist yet.
+func recordedVersions(ctx context.Context, db *sqlx.DB) (map[string]time.Time, error) {
+	for _, table := range []string{"schema_version", legacyTable} {
+		exists, err := tableExists(ctx, db, table)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return appliedVersions(ctx, db, table)
+		}
+	}
+	return map[string]time.Time{}, nil
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory
+// lock, after making sure the schema_version table exists.
+func withLock(ctx context.Context, db *sqlx.DB, fn func(conn *sqlx.Conn) error) error {
+	conn, err := db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockID)
+
+	if err := ensureVersionTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// legacyTable is where the sql-migrate CLI recorded applied migrations.
+const legacyTable = "gorp_migrations"
+
+// ensureVersionTable creates schema_version. When it creates it on a
+// database migrated by the sql-migrate CLI before, the history is copied over
+// from gorp_migrations in the same transaction so nothing is applied twice.
+// Later runs leave schema_version alone, so versions rolled back with Down
+// stay rolled back.
+func ensureVersionTable(ctx context.Context, conn *sqlx.Conn) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	exists, err := tableExists(ctx, tx, "schema_version")
+	if err != nil || exists {
+		return err
+	}
+	query := `
+		CREATE TABLE schema_version (
+			version VARCHAR(255) PRIMARY KEY,
+			applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		)`
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+
+	legacy, err := tableExists(ctx, tx, legacyTable)
+	if err != nil {
+		return err
+	}
+	if legacy {
+		importQuery := `
+			INSERT INTO schema_version (version, applied_at)
+			SELECT id, COALESCE(applied_at, NOW()) FROM gorp_migrations`
+		if _, err := tx.ExecContext(ctx, importQuery); err != nil {
+			return fmt.Errorf("failed to import gorp_migrations: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+	return nil
+}
+
+func tableExists(ctx context.Context, q sqlx.QueryerContext, table string) (bool, error) {
+	var exists bool
+	if err := sqlx.GetContext(ctx, q, &exists, `SELECT to_regclass($1) IS NOT NULL`, table); err != nil {
+		return false, fmt.Errorf("failed to look for table %s: %w", table, err)
+	}
+	return exists, nil
+}
+
+// appliedVersions reads the applied versions from schema_version, or from
+// gorp_migrations, whose version column is called id.
+func appliedVersions(ctx context.Context, q sqlx.QueryerContext, table string) (map[string]time.Time, error) {
+	var rows []struct {
+		Version   string    `db:"version"`
+		AppliedAt time.Time `db:"applied_at"`
+	}
+	query := `SELECT version, applied_at FROM schema_version`
+	if table == legacyTable {
+		query = `SELECT id AS version, COALESCE(applied_at, NOW()) AS applied_at FROM gorp_migrations`
+	}
+	if err := sqlx.SelectContext(ctx, q, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", table, err)
+	}
+	done := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		done[row.Version] = row.AppliedAt
+	}
+	return done, nil
+}
+
+// apply runs script and records (up) or forgets (down) the version in the
+// same transaction.
+func apply(ctx context.Context, conn *sqlx.Conn, version, script string, up bool) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if script != "" {
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			return fmt.Errorf("migration %s failed: %w", version, err)
+		}
+	}
+	record := `INSERT INTO schema_version (version) VALUES ($1)`
+	if !up {
+		record = `DELETE FROM schema_version WHERE version = $1`
+	}
+	if _, err := tx.ExecContext(ctx, record, version); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", version, err)
+	}
+	return nil
+}
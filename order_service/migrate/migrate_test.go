@@ -0,0 +1,142 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+// testDB connects to the Postgres database in MIGRATE_TEST_DB_URL with a
+// fresh schema first on the search path, so every test starts empty.
+func testDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	dsn := os.Getenv("MIGRATE_TEST_DB_URL")
+	if dsn == "" {
+		t.Skip("MIGRATE_TEST_DB_URL is not set")
+	}
+
+	admin, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	schema := fmt.Sprintf("migrate_test_%d", time.Now().UnixNano())
+	if _, err := admin.Exec(`CREATE SCHEMA ` + schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		admin.Exec(`DROP SCHEMA ` + schema + ` CASCADE`)
+		admin.Close()
+	})
+
+	if strings.Contains(dsn, "://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			t.Fatalf("invalid MIGRATE_TEST_DB_URL: %v", err)
+		}
+		q := u.Query()
+		q.Set("search_path", schema)
+		u.RawQuery = q.Encode()
+		dsn = u.String()
+	} else {
+		dsn += " search_path=" + schema
+	}
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestUpAndDown(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	applied, err := Up(ctx, db)
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if applied != len(migrations) {
+		t.Errorf("Up applied %d migrations, want %d", applied, len(migrations))
+	}
+	if applied, err := Up(ctx, db); err != nil || applied != 0 {
+		t.Errorf("second Up applied %d migrations (%v), want 0", applied, err)
+	}
+
+	rolledBack, err := Down(ctx, db, len(migrations))
+	if err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	if rolledBack != len(migrations) {
+		t.Errorf("Down rolled back %d migrations, want %d", rolledBack, len(migrations))
+	}
+	statuses, err := CurrentStatus(ctx, db)
+	if err != nil {
+		t.Fatalf("CurrentStatus: %v", err)
+	}
+	for _, s := range statuses {
+		if s.AppliedAt != nil {
+			t.Errorf("migration %s still applied after Down", s.Version)
+		}
+	}
+}
+
+func TestGorpHistoryIsImportedOnce(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	first := migrations[0]
+
+	// The sql-migrate CLI recorded the first migration before this package
+	// took over.
+	db.MustExec(`CREATE TABLE gorp_migrations (id TEXT PRIMARY KEY, applied_at TIMESTAMP WITH TIME ZONE)`)
+	db.MustExec(`INSERT INTO gorp_migrations (id, applied_at) VALUES ($1, NOW())`, first.Version)
+	db.MustExec(first.Up)
+
+	statuses, err := CurrentStatus(ctx, db)
+	if err != nil {
+		t.Fatalf("CurrentStatus: %v", err)
+	}
+	if statuses[0].AppliedAt == nil {
+		t.Errorf("CurrentStatus does not report %s from gorp_migrations", first.Version)
+	}
+	var created bool
+	db.Get(&created, `SELECT to_regclass('schema_version') IS NOT NULL`)
+	if created {
+		t.Error("CurrentStatus created schema_version")
+	}
+
+	applied, err := Up(ctx, db)
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if applied != len(migrations)-1 {
+		t.Errorf("Up applied %d migrations, want %d", applied, len(migrations)-1)
+	}
+
+	// A rolled back migration must not come back from gorp_migrations.
+	if _, err := Down(ctx, db, len(migrations)); err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	applied, err = Up(ctx, db)
+	if err != nil {
+		t.Fatalf("Up after Down: %v", err)
+	}
+	if applied != len(migrations) {
+		t.Errorf("Up after Down applied %d migrations, want %d", applied, len(migrations))
+	}
+}
@@ -0,0 +1,45 @@
+-- +migrate Up
+CREATE TABLE order_items (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    product_id VARCHAR(255) NOT NULL,
+    category VARCHAR(100) NOT NULL,
+    product_name VARCHAR(255) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(10,2) NOT NULL,
+    line_total NUMERIC(10,2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_items_order_id ON order_items(order_id);
+
+-- Existing orders had a single line. The product name was never stored, so
+-- those lines get a placeholder; the order id doubles as the line id.
+INSERT INTO order_items (id, order_id, product_id, category, product_name, quantity, unit_price, line_total, created_at)
+SELECT id, id, product_id, category, '(unknown product)', quantity,
+       ROUND(COALESCE(total_amount, 0) / quantity, 2), COALESCE(total_amount, 0), created_at
+FROM orders
+WHERE quantity > 0;
+
+ALTER TABLE orders
+    DROP COLUMN product_id,
+    DROP COLUMN category,
+    DROP COLUMN quantity;
+
+-- +migrate Down
+ALTER TABLE orders
+    ADD COLUMN product_id VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN category VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN quantity INT NOT NULL DEFAULT 0;
+
+-- Orders keep their first line only.
+UPDATE orders o
+SET product_id = i.product_id, category = i.category, quantity = i.quantity
+FROM (
+    SELECT DISTINCT ON (order_id) order_id, product_id, category, quantity
+    FROM order_items
+    ORDER BY order_id, created_at, id
+) i
+WHERE i.order_id = o.id;
+
+DROP TABLE IF EXISTS order_items;
@@ -0,0 +1,18 @@
+-- +migrate Up
+DROP INDEX IF EXISTS idx_orders_user_id;
+DROP INDEX IF EXISTS idx_orders_status;
+DROP INDEX IF EXISTS idx_orders_created_at;
+
+-- Keyset pagination walks (created_at, id) newest first, optionally scoped by owner or status.
+CREATE INDEX idx_orders_user_created ON orders(user_id, created_at DESC, id DESC);
+CREATE INDEX idx_orders_status_created ON orders(status, created_at DESC, id DESC);
+CREATE INDEX idx_orders_created ON orders(created_at DESC, id DESC);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_orders_user_created;
+DROP INDEX IF EXISTS idx_orders_status_created;
+DROP INDEX IF EXISTS idx_orders_created;
+
+CREATE INDEX idx_orders_user_id ON orders(user_id);
+CREATE INDEX idx_orders_status ON orders(status);
+CREATE INDEX idx_orders_created_at ON orders(created_at);
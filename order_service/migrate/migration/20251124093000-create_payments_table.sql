@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE payments (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    authorization_id VARCHAR(255),
+    capture_id VARCHAR(255),
+    amount NUMERIC(10,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    failure_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_payments_order_id ON payments(order_id, created_at DESC);
+CREATE UNIQUE INDEX idx_payments_authorization ON payments(provider, authorization_id) WHERE authorization_id IS NOT NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS payments;
@@ -0,0 +1,42 @@
+-- +migrate Up
+ALTER TABLE orders ADD COLUMN refunded_amount NUMERIC(10,2) NOT NULL DEFAULT 0;
+ALTER TABLE order_items
+    ADD COLUMN refunded_quantity INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT order_items_refunded_quantity_check CHECK (refunded_quantity BETWEEN 0 AND quantity);
+
+CREATE TABLE refunds (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    payment_id VARCHAR(255) NOT NULL REFERENCES payments(id),
+    provider_refund_id VARCHAR(255),
+    amount NUMERIC(10,2) NOT NULL CHECK (amount > 0),
+    reason TEXT NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    failure_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_refunds_order_id ON refunds(order_id, created_at);
+
+CREATE TABLE refund_items (
+    id BIGSERIAL PRIMARY KEY,
+    refund_id VARCHAR(255) NOT NULL REFERENCES refunds(id) ON DELETE CASCADE,
+    order_item_id VARCHAR(255) NOT NULL REFERENCES order_items(id) ON DELETE CASCADE,
+    product_id VARCHAR(255) NOT NULL,
+    category VARCHAR(100) NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    amount NUMERIC(10,2) NOT NULL
+);
+
+CREATE INDEX idx_refund_items_refund_id ON refund_items(refund_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS refund_items;
+DROP TABLE IF EXISTS refunds;
+
+ALTER TABLE order_items
+    DROP CONSTRAINT IF EXISTS order_items_refunded_quantity_check,
+    DROP COLUMN IF EXISTS refunded_quantity;
+ALTER TABLE orders DROP COLUMN IF EXISTS refunded_amount;
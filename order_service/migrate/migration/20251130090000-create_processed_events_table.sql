@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE processed_events (
+    consumer_group VARCHAR(255) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    processed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (consumer_group, event_id)
+);
+
+CREATE INDEX idx_processed_events_processed_at ON processed_events(processed_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS processed_events;
@@ -0,0 +1,27 @@
+-- +migrate Up
+-- invoice_counters hands out gap-free invoice numbers per year; the counter
+-- row stays locked until the invoice using the number commits.
+CREATE TABLE invoice_counters (
+    year INT PRIMARY KEY,
+    last_number BIGINT NOT NULL
+);
+
+CREATE TABLE invoices (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    invoice_number VARCHAR(50) NOT NULL UNIQUE,
+    buyer_name VARCHAR(255) NOT NULL DEFAULT '',
+    buyer_email VARCHAR(255) NOT NULL,
+    buyer_phone VARCHAR(50) NOT NULL DEFAULT '',
+    buyer_address TEXT NOT NULL DEFAULT '',
+    currency VARCHAR(10) NOT NULL,
+    subtotal NUMERIC(10,2) NOT NULL,
+    tax_rate NUMERIC(6,4) NOT NULL,
+    tax_amount NUMERIC(10,2) NOT NULL,
+    total NUMERIC(10,2) NOT NULL,
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS invoice_counters;
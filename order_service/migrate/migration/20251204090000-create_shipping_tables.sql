@@ -0,0 +1,31 @@
+-- +migrate Up
+CREATE TABLE order_shipping_addresses (
+    order_id VARCHAR(255) PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    recipient_name VARCHAR(200) NOT NULL,
+    line1 VARCHAR(200) NOT NULL,
+    line2 VARCHAR(200) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL,
+    state VARCHAR(100) NOT NULL DEFAULT '',
+    postal_code VARCHAR(20) NOT NULL,
+    country VARCHAR(2) NOT NULL,
+    phone VARCHAR(30) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE shipments (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    carrier VARCHAR(100) NOT NULL,
+    tracking_number VARCHAR(100) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    shipped_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (carrier, tracking_number)
+);
+
+CREATE INDEX idx_shipments_order_id ON shipments(order_id, shipped_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS shipments;
+DROP TABLE IF EXISTS order_shipping_addresses;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- Lets the relay find earlier unsent messages with the same key.
+CREATE INDEX idx_outbox_pending_key ON outbox(event_key, id) WHERE sent_at IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_outbox_pending_key;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- Lets the refund reconciler find refunds left pending with the provider.
+CREATE INDEX idx_refunds_pending ON refunds(updated_at) WHERE status = 'pending';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_refunds_pending;
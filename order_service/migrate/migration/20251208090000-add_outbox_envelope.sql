@@ -0,0 +1,7 @@
+-- +migrate Up
+-- Rows staged before events were enveloped keep an empty envelope, so the
+-- relay publishes their bare payloads without the envelope header.
+ALTER TABLE outbox ADD COLUMN envelope VARCHAR(10) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE outbox DROP COLUMN IF EXISTS envelope;
@@ -0,0 +1,1668 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: cart/cart.proto
+
+package cartpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AddToCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddToCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddToCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddToCartRequestMultiError, or nil if none found.
+func (m *AddToCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddToCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProductId()) < 1 {
+		err := AddToCartRequestValidationError{
+			field:  "ProductId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCategory()) < 1 {
+		err := AddToCartRequestValidationError{
+			field:  "Category",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := AddToCartRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := AddToCartRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddToCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddToCartRequestMultiError is an error wrapping multiple validation errors
+// returned by AddToCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddToCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddToCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddToCartRequestMultiError) AllErrors() []error { return m }
+
+// AddToCartRequestValidationError is the validation error returned by
+// AddToCartRequest.Validate if the designated constraints aren't met.
+type AddToCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddToCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddToCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddToCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddToCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddToCartRequestValidationError) ErrorName() string { return "AddToCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddToCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddToCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddToCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddToCartRequestValidationError{}
+
+// Validate checks the field values on GetCartRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCartRequestMultiError,
+// or nil if none found.
+func (m *GetCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplyUpdates
+
+	if len(errors) > 0 {
+		return GetCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCartRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCartRequestMultiError) AllErrors() []error { return m }
+
+// GetCartRequestValidationError is the validation error returned by
+// GetCartRequest.Validate if the designated constraints aren't met.
+type GetCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCartRequestValidationError) ErrorName() string { return "GetCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCartRequestValidationError{}
+
+// Validate checks the field values on UpdateCartItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCartItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCartItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCartItemRequestMultiError, or nil if none found.
+func (m *UpdateCartItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCartItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProductId()) < 1 {
+		err := UpdateCartItemRequestValidationError{
+			field:  "ProductId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() < 0 {
+		err := UpdateCartItemRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := UpdateCartItemRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateCartItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCartItemRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCartItemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCartItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCartItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCartItemRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCartItemRequestValidationError is the validation error returned by
+// UpdateCartItemRequest.Validate if the designated constraints aren't met.
+type UpdateCartItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCartItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCartItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCartItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCartItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCartItemRequestValidationError) ErrorName() string {
+	return "UpdateCartItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCartItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCartItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCartItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCartItemRequestValidationError{}
+
+// Validate checks the field values on RemoveFromCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFromCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFromCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFromCartRequestMultiError, or nil if none found.
+func (m *RemoveFromCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFromCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProductId()) < 1 {
+		err := RemoveFromCartRequestValidationError{
+			field:  "ProductId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := RemoveFromCartRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveFromCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFromCartRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveFromCartRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveFromCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFromCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFromCartRequestMultiError) AllErrors() []error { return m }
+
+// RemoveFromCartRequestValidationError is the validation error returned by
+// RemoveFromCartRequest.Validate if the designated constraints aren't met.
+type RemoveFromCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFromCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFromCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFromCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFromCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFromCartRequestValidationError) ErrorName() string {
+	return "RemoveFromCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFromCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFromCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFromCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFromCartRequestValidationError{}
+
+// Validate checks the field values on ClearCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClearCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearCartRequestMultiError, or nil if none found.
+func (m *ClearCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetExpectedVersion() < 0 {
+		err := ClearCartRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearCartRequestMultiError is an error wrapping multiple validation errors
+// returned by ClearCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClearCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearCartRequestMultiError) AllErrors() []error { return m }
+
+// ClearCartRequestValidationError is the validation error returned by
+// ClearCartRequest.Validate if the designated constraints aren't met.
+type ClearCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearCartRequestValidationError) ErrorName() string { return "ClearCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClearCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearCartRequestValidationError{}
+
+// Validate checks the field values on CreateGuestSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGuestSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGuestSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGuestSessionRequestMultiError, or nil if none found.
+func (m *CreateGuestSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGuestSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateGuestSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGuestSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateGuestSessionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateGuestSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGuestSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGuestSessionRequestMultiError) AllErrors() []error { return m }
+
+// CreateGuestSessionRequestValidationError is the validation error returned by
+// CreateGuestSessionRequest.Validate if the designated constraints aren't met.
+type CreateGuestSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGuestSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGuestSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGuestSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGuestSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGuestSessionRequestValidationError) ErrorName() string {
+	return "CreateGuestSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGuestSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGuestSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGuestSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGuestSessionRequestValidationError{}
+
+// Validate checks the field values on MergeCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeCartRequestMultiError, or nil if none found.
+func (m *MergeCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSessionId()); l < 1 || l > 100 {
+		err := MergeCartRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _MergeCartRequest_Strategy_InLookup[m.GetStrategy()]; !ok {
+		err := MergeCartRequestValidationError{
+			field:  "Strategy",
+			reason: "value must be in list [ sum max newest]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeCartRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeCartRequestMultiError) AllErrors() []error { return m }
+
+// MergeCartRequestValidationError is the validation error returned by
+// MergeCartRequest.Validate if the designated constraints aren't met.
+type MergeCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeCartRequestValidationError) ErrorName() string { return "MergeCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeCartRequestValidationError{}
+
+var _MergeCartRequest_Strategy_InLookup = map[string]struct{}{
+	"":       {},
+	"sum":    {},
+	"max":    {},
+	"newest": {},
+}
+
+// Validate checks the field values on GuestSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GuestSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuestSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GuestSessionMultiError, or
+// nil if none found.
+func (m *GuestSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuestSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GuestSessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GuestSessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GuestSessionValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GuestSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuestSessionMultiError is an error wrapping multiple validation errors
+// returned by GuestSession.ValidateAll() if the designated constraints aren't met.
+type GuestSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuestSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuestSessionMultiError) AllErrors() []error { return m }
+
+// GuestSessionValidationError is the validation error returned by
+// GuestSession.Validate if the designated constraints aren't met.
+type GuestSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuestSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuestSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuestSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuestSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuestSessionValidationError) ErrorName() string { return "GuestSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuestSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuestSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuestSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuestSessionValidationError{}
+
+// Validate checks the field values on CartItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CartItemMultiError, or nil
+// if none found.
+func (m *CartItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	// no validation rules for Category
+
+	// no validation rules for ProductName
+
+	// no validation rules for Price
+
+	// no validation rules for Quantity
+
+	// no validation rules for ImageUrl
+
+	// no validation rules for Subtotal
+
+	if len(errors) > 0 {
+		return CartItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartItemMultiError is an error wrapping multiple validation errors returned
+// by CartItem.ValidateAll() if the designated constraints aren't met.
+type CartItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartItemMultiError) AllErrors() []error { return m }
+
+// CartItemValidationError is the validation error returned by
+// CartItem.Validate if the designated constraints aren't met.
+type CartItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartItemValidationError) ErrorName() string { return "CartItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartItemValidationError{}
+
+// Validate checks the field values on CartResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CartResponseMultiError, or
+// nil if none found.
+func (m *CartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalItems
+
+	// no validation rules for Subtotal
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CartResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CartResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CartResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CartResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CartResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CartResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Revalidated
+
+	if len(errors) > 0 {
+		return CartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartResponseMultiError is an error wrapping multiple validation errors
+// returned by CartResponse.ValidateAll() if the designated constraints aren't met.
+type CartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartResponseMultiError) AllErrors() []error { return m }
+
+// CartResponseValidationError is the validation error returned by
+// CartResponse.Validate if the designated constraints aren't met.
+type CartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartResponseValidationError) ErrorName() string { return "CartResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartResponseValidationError{}
+
+// Validate checks the field values on CartItemWarning with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CartItemWarning) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartItemWarning with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CartItemWarningMultiError, or nil if none found.
+func (m *CartItemWarning) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartItemWarning) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for CartPrice
+
+	// no validation rules for CurrentPrice
+
+	// no validation rules for Available
+
+	if len(errors) > 0 {
+		return CartItemWarningMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartItemWarningMultiError is an error wrapping multiple validation errors
+// returned by CartItemWarning.ValidateAll() if the designated constraints
+// aren't met.
+type CartItemWarningMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartItemWarningMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartItemWarningMultiError) AllErrors() []error { return m }
+
+// CartItemWarningValidationError is the validation error returned by
+// CartItemWarning.Validate if the designated constraints aren't met.
+type CartItemWarningValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartItemWarningValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartItemWarningValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartItemWarningValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartItemWarningValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartItemWarningValidationError) ErrorName() string { return "CartItemWarningValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartItemWarningValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartItemWarning.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartItemWarningValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartItemWarningValidationError{}
+
+// Validate checks the field values on CartStandardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CartStandardResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartStandardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CartStandardResponseMultiError, or nil if none found.
+func (m *CartStandardResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartStandardResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	// no validation rules for StatusCode
+
+	switch v := m.Result.(type) {
+	case *CartStandardResponse_CartData:
+		if v == nil {
+			err := CartStandardResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCartData()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartStandardResponseValidationError{
+						field:  "CartData",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartStandardResponseValidationError{
+						field:  "CartData",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCartData()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartStandardResponseValidationError{
+					field:  "CartData",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CartStandardResponse_GuestSession:
+		if v == nil {
+			err := CartStandardResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGuestSession()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartStandardResponseValidationError{
+						field:  "GuestSession",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartStandardResponseValidationError{
+						field:  "GuestSession",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGuestSession()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartStandardResponseValidationError{
+					field:  "GuestSession",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CartStandardResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartStandardResponseMultiError is an error wrapping multiple validation
+// errors returned by CartStandardResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CartStandardResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartStandardResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartStandardResponseMultiError) AllErrors() []error { return m }
+
+// CartStandardResponseValidationError is the validation error returned by
+// CartStandardResponse.Validate if the designated constraints aren't met.
+type CartStandardResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartStandardResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartStandardResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartStandardResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartStandardResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartStandardResponseValidationError) ErrorName() string {
+	return "CartStandardResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CartStandardResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartStandardResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartStandardResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartStandardResponseValidationError{}
@@ -35,6 +35,149 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on EventEnvelope with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventEnvelope) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventEnvelope with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventEnvelopeMultiError, or
+// nil if none found.
+func (m *EventEnvelope) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventEnvelope) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for SchemaVersion
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventEnvelopeValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventEnvelopeValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventEnvelopeValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CorrelationId
+
+	// no validation rules for Producer
+
+	// no validation rules for Payload
+
+	// no validation rules for EnvelopeVersion
+
+	if len(errors) > 0 {
+		return EventEnvelopeMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventEnvelopeMultiError is an error wrapping multiple validation errors
+// returned by EventEnvelope.ValidateAll() if the designated constraints
+// aren't met.
+type EventEnvelopeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventEnvelopeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventEnvelopeMultiError) AllErrors() []error { return m }
+
+// EventEnvelopeValidationError is the validation error returned by
+// EventEnvelope.Validate if the designated constraints aren't met.
+type EventEnvelopeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventEnvelopeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventEnvelopeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventEnvelopeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventEnvelopeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventEnvelopeValidationError) ErrorName() string { return "EventEnvelopeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventEnvelopeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventEnvelope.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventEnvelopeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventEnvelopeValidationError{}
+
 // Validate checks the field values on OrderCreatedEvent with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -42,15 +185,403 @@ func (m *OrderCreatedEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderCreatedEvent with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrderCreatedEventMultiError, or nil if none found.
-func (m *OrderCreatedEvent) ValidateAll() error {
+// ValidateAll checks the field values on OrderCreatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderCreatedEventMultiError, or nil if none found.
+func (m *OrderCreatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderCreatedEventValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderCreatedEventValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderCreatedEventValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderCreatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCreatedEventMultiError is an error wrapping multiple validation errors
+// returned by OrderCreatedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCreatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreatedEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreatedEventMultiError) AllErrors() []error { return m }
+
+// OrderCreatedEventValidationError is the validation error returned by
+// OrderCreatedEvent.Validate if the designated constraints aren't met.
+type OrderCreatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreatedEventValidationError) ErrorName() string {
+	return "OrderCreatedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderCreatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreatedEventValidationError{}
+
+// Validate checks the field values on OrderCancelledEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderCancelledEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCancelledEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderCancelledEventMultiError, or nil if none found.
+func (m *OrderCancelledEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCancelledEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for UserId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return OrderCancelledEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCancelledEventMultiError is an error wrapping multiple validation
+// errors returned by OrderCancelledEvent.ValidateAll() if the designated
+// constraints aren't met.
+type OrderCancelledEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCancelledEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCancelledEventMultiError) AllErrors() []error { return m }
+
+// OrderCancelledEventValidationError is the validation error returned by
+// OrderCancelledEvent.Validate if the designated constraints aren't met.
+type OrderCancelledEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCancelledEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCancelledEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCancelledEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCancelledEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCancelledEventValidationError) ErrorName() string {
+	return "OrderCancelledEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderCancelledEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCancelledEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCancelledEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCancelledEventValidationError{}
+
+// Validate checks the field values on OrderRefundedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderRefundedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderRefundedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderRefundedEventMultiError, or nil if none found.
+func (m *OrderRefundedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderRefundedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for RefundId
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderRefundedEventValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderRefundedEventValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderRefundedEventValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderRefundedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderRefundedEventMultiError is an error wrapping multiple validation errors
+// returned by OrderRefundedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type OrderRefundedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderRefundedEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderRefundedEventMultiError) AllErrors() []error { return m }
+
+// OrderRefundedEventValidationError is the validation error returned by
+// OrderRefundedEvent.Validate if the designated constraints aren't met.
+type OrderRefundedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderRefundedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderRefundedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderRefundedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderRefundedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderRefundedEventValidationError) ErrorName() string {
+	return "OrderRefundedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderRefundedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderRefundedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderRefundedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderRefundedEventValidationError{}
+
+// Validate checks the field values on OrderPaidEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderPaidEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderPaidEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderPaidEventMultiError,
+// or nil if none found.
+func (m *OrderPaidEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderCreatedEvent) validate(all bool) error {
+func (m *OrderPaidEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -59,56 +590,126 @@ func (m *OrderCreatedEvent) validate(all bool) error {
 
 	// no validation rules for OrderId
 
-	// no validation rules for UserId
+	// no validation rules for PaymentId
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return OrderPaidEventMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, OrderCreatedEventValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, OrderCreatedEventValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return OrderCreatedEventValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
+
+// OrderPaidEventMultiError is an error wrapping multiple validation errors
+// returned by OrderPaidEvent.ValidateAll() if the designated constraints
+// aren't met.
+type OrderPaidEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderPaidEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderPaidEventMultiError) AllErrors() []error { return m }
+
+// OrderPaidEventValidationError is the validation error returned by
+// OrderPaidEvent.Validate if the designated constraints aren't met.
+type OrderPaidEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderPaidEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderPaidEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderPaidEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderPaidEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderPaidEventValidationError) ErrorName() string { return "OrderPaidEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderPaidEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderPaidEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderPaidEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderPaidEventValidationError{}
+
+// Validate checks the field values on OrderShippedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderShippedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderShippedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderShippedEventMultiError, or nil if none found.
+func (m *OrderShippedEvent) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *OrderShippedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for ShipmentId
+
 	if len(errors) > 0 {
-		return OrderCreatedEventMultiError(errors)
+		return OrderShippedEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderCreatedEventMultiError is an error wrapping multiple validation errors
-// returned by OrderCreatedEvent.ValidateAll() if the designated constraints
+// OrderShippedEventMultiError is an error wrapping multiple validation errors
+// returned by OrderShippedEvent.ValidateAll() if the designated constraints
 // aren't met.
-type OrderCreatedEventMultiError []error
+type OrderShippedEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderCreatedEventMultiError) Error() string {
+func (m OrderShippedEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -117,11 +718,11 @@ func (m OrderCreatedEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderCreatedEventMultiError) AllErrors() []error { return m }
+func (m OrderShippedEventMultiError) AllErrors() []error { return m }
 
-// OrderCreatedEventValidationError is the validation error returned by
-// OrderCreatedEvent.Validate if the designated constraints aren't met.
-type OrderCreatedEventValidationError struct {
+// OrderShippedEventValidationError is the validation error returned by
+// OrderShippedEvent.Validate if the designated constraints aren't met.
+type OrderShippedEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -129,24 +730,24 @@ type OrderCreatedEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderCreatedEventValidationError) Field() string { return e.field }
+func (e OrderShippedEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderCreatedEventValidationError) Reason() string { return e.reason }
+func (e OrderShippedEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderCreatedEventValidationError) Cause() error { return e.cause }
+func (e OrderShippedEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderCreatedEventValidationError) Key() bool { return e.key }
+func (e OrderShippedEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderCreatedEventValidationError) ErrorName() string {
-	return "OrderCreatedEventValidationError"
+func (e OrderShippedEventValidationError) ErrorName() string {
+	return "OrderShippedEventValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OrderCreatedEventValidationError) Error() string {
+func (e OrderShippedEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -158,14 +759,14 @@ func (e OrderCreatedEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderCreatedEvent.%s: %s%s",
+		"invalid %sOrderShippedEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderCreatedEventValidationError{}
+var _ error = OrderShippedEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -173,7 +774,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderCreatedEventValidationError{}
+} = OrderShippedEventValidationError{}
 
 // Validate checks the field values on OrderItem with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -201,6 +802,8 @@ func (m *OrderItem) validate(all bool) error {
 
 	// no validation rules for Quantity
 
+	// no validation rules for Category
+
 	if len(errors) > 0 {
 		return OrderItemMultiError(errors)
 	}
@@ -306,6 +909,40 @@ func (m *OrderValidationResultEvent) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	for idx, item := range m.GetItemErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderValidationResultEventValidationError{
+						field:  fmt.Sprintf("ItemErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderValidationResultEventValidationError{
+						field:  fmt.Sprintf("ItemErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderValidationResultEventValidationError{
+					field:  fmt.Sprintf("ItemErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return OrderValidationResultEventMultiError(errors)
 	}
@@ -385,3 +1022,107 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = OrderValidationResultEventValidationError{}
+
+// Validate checks the field values on OrderItemError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderItemError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItemError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderItemErrorMultiError,
+// or nil if none found.
+func (m *OrderItemError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItemError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return OrderItemErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderItemErrorMultiError is an error wrapping multiple validation errors
+// returned by OrderItemError.ValidateAll() if the designated constraints
+// aren't met.
+type OrderItemErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemErrorMultiError) AllErrors() []error { return m }
+
+// OrderItemErrorValidationError is the validation error returned by
+// OrderItemError.Validate if the designated constraints aren't met.
+type OrderItemErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemErrorValidationError) ErrorName() string { return "OrderItemErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderItemErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItemError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemErrorValidationError{}
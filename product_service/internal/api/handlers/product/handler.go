@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	inventoryservice "product_service/internal/services/inventoryService"
 	productservice "product_service/internal/services/productService"
 	"product_service/internal/utils"
 	productpb "product_service/proto/gen"
@@ -13,12 +14,14 @@ import (
 
 type handler struct {
 	productpb.UnimplementedProductServiceServer
-	service productservice.Service
+	service          productservice.Service
+	inventoryService inventoryservice.Service
 }
 
-func NewProductHandler(service productservice.Service) *handler {
+func NewProductHandler(service productservice.Service, inventoryService inventoryservice.Service) *handler {
 	return &handler{
-		service: service,
+		service:          service,
+		inventoryService: inventoryService,
 	}
 
 }
@@ -89,6 +92,24 @@ func (h *handler) GetProductById(ctx context.Context, req *productpb.GetProductB
 
 }
 
+func (h *handler) BatchGetProducts(ctx context.Context, req *productpb.BatchGetProductsRequest) (*productpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	result, err := h.service.BatchGet(ctx, req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &productpb.StandardResponse{
+		Success:    true,
+		Message:    "products fetched successfully",
+		StatusCode: 200,
+		Result: &productpb.StandardResponse_BatchProducts{
+			BatchProducts: result,
+		},
+	}, nil
+}
+
 func (h *handler) UpdateProduct(ctx context.Context, req *productpb.UpdateProductRequest) (*productpb.StandardResponse, error) {
 	if err := req.ValidateAll(); err != nil {
 		return nil, utils.MapError(err)
@@ -0,0 +1,106 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"product_service/internal/utils"
+	productpb "product_service/proto/gen"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func (h *handler) AdjustStock(ctx context.Context, req *productpb.AdjustStockRequest) (*productpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := userEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	result, err := h.inventoryService.AdjustStock(ctx, email, req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &productpb.StandardResponse{
+		Success:    true,
+		Message:    "stock adjusted successfully",
+		StatusCode: 200,
+		Result: &productpb.StandardResponse_StockAdjustment{
+			StockAdjustment: result,
+		},
+	}, nil
+}
+
+func (h *handler) ListStockAdjustments(ctx context.Context, req *productpb.ListStockAdjustmentsRequest) (*productpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+	email, err := userEmail(ctx)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	result, err := h.inventoryService.ListStockAdjustments(ctx, email, req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &productpb.StandardResponse{
+		Success:    true,
+		Message:    "stock adjustments fetched successfully",
+		StatusCode: 200,
+		Result: &productpb.StandardResponse_StockAdjustments{
+			StockAdjustments: result,
+		},
+	}, nil
+}
+
+func (h *handler) ReserveStock(ctx context.Context, req *productpb.ReserveStockRequest) (*productpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	reservation, err := h.inventoryService.ReserveStock(ctx, req)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &productpb.StandardResponse{
+		Success:    true,
+		Message:    "stock reserved successfully",
+		StatusCode: 200,
+		Result: &productpb.StandardResponse_Reservation{
+			Reservation: reservation,
+		},
+	}, nil
+}
+
+func (h *handler) ReleaseStock(ctx context.Context, req *productpb.ReleaseStockRequest) (*productpb.StandardResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, utils.MapError(err)
+	}
+
+	reservation, err := h.inventoryService.ReleaseStock(ctx, req.ReservationId)
+	if err != nil {
+		return nil, utils.MapError(err)
+	}
+	return &productpb.StandardResponse{
+		Success:    true,
+		Message:    "stock released successfully",
+		StatusCode: 200,
+		Result: &productpb.StandardResponse_Reservation{
+			Reservation: reservation,
+		},
+	}, nil
+}
+
+func userEmail(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("missing authentication metadata")
+	}
+	emails := md.Get("x-user-email")
+	if len(emails) == 0 {
+		return "", errors.New("user email not found in metadata")
+	}
+	return emails[0], nil
+}
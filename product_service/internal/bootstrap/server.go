@@ -8,26 +8,44 @@ import (
 	"product_service/internal/api/handlers/product"
 	client "product_service/internal/client/product"
 	"product_service/internal/config"
+	"product_service/internal/infra"
 	"product_service/internal/infra/db"
 	"product_service/internal/interceptors"
+	"product_service/internal/kafka"
 	"product_service/internal/migrations"
+	inventoryrepo "product_service/internal/repo/inventoryRepo"
+	processedeventrepo "product_service/internal/repo/processedEventRepo"
 	productrepo "product_service/internal/repo/productRepo"
+	inventoryservice "product_service/internal/services/inventoryService"
 	productservice "product_service/internal/services/productService"
 	productpb "product_service/proto/gen"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"google.golang.org/grpc"
 )
 
+const reservationSweepInterval = 30 * time.Second
+
 type App struct {
-	server   *grpc.Server
-	listener net.Listener
-	cfg      *config.Config
+	server           *grpc.Server
+	listener         net.Listener
+	cfg              *config.Config
+	consumer         kafka.Consumer
+	inventoryService inventoryservice.Service
+	prodClose        func() error
+	consClose        func() error
 }
 
 func InitializeApp(ctx context.Context, cfg *config.Config) (*App, error) {
 
-	server := grpc.NewServer(grpc.UnaryInterceptor(interceptors.ErrorInterCeptor()))
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptors.ErrorInterCeptor(),
+		interceptors.InternalOnlyInterceptor(cfg.InternalToken,
+			productpb.ProductService_ReserveStock_FullMethodName,
+			productpb.ProductService_ReleaseStock_FullMethodName),
+	))
 	userclient, closeUserClient, err := client.NewClient(ctx, cfg.UserServiceAddress)
 
 	if err != nil {
@@ -44,6 +62,17 @@ func InitializeApp(ctx context.Context, cfg *config.Config) (*App, error) {
 	client := dynamodb.NewFromConfig(dynamoDBConfig)
 	log.Println("dynamo db connected")
 	migrations.InitProductTable(client)
+	migrations.InitReservationTable(client)
+	migrations.InitStockAdjustmentTable(client)
+	migrations.InitProcessedEventTable(client)
+
+	kafkaInfra := infra.NewKafkaInfra(cfg.KafkaBrokers)
+	producer, prodClose := kafka.NewProducer(kafkaInfra.Writer(kafka.OrderResultsTopic))
+	deadLetters := kafkaInfra.Writer(kafka.OrderEventsDeadLetterTopic)
+	// The dead-letter topic is rarely written, so it is created on first use.
+	deadLetters.AllowAutoTopicCreation = true
+	consumer, consClose := kafka.NewConsumer(kafkaInfra.Reader(kafka.OrderEventsTopic, "product_service_group"),
+		deadLetters, cfg.KafkaMaxAttempts, processedeventrepo.NewRepo(client, "ProcessedEvents"))
 
 	go func() {
 		<-ctx.Done()
@@ -53,16 +82,38 @@ func InitializeApp(ctx context.Context, cfg *config.Config) (*App, error) {
 
 	productRepo := productrepo.NewRepo(client, "Products")
 	productService := productservice.NewService(userclient, productRepo)
-	productHandler := product.NewProductHandler(productService)
+	inventoryRepo := inventoryrepo.NewRepo(client, "Products", "Reservations", "StockAdjustments")
+	inventoryService := inventoryservice.NewService(productRepo, inventoryRepo, producer, cfg.OrderReservationTTL)
+	productHandler := product.NewProductHandler(productService, inventoryService)
 	productpb.RegisterProductServiceServer(server, productHandler)
 	return &App{
-		server:   server,
-		listener: listener,
-		cfg:      cfg,
+		server:           server,
+		listener:         listener,
+		cfg:              cfg,
+		consumer:         consumer,
+		inventoryService: inventoryService,
+		prodClose:        prodClose,
+		consClose:        consClose,
 	}, nil
 }
 
 func (a *App) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		a.consumer.StartOrderListener(ctx, kafka.OrderEventHandlers{
+			Created:   a.inventoryService.HandleOrderCreated,
+			Cancelled: a.inventoryService.HandleOrderCancelled,
+			Refunded:  a.inventoryService.HandleOrderRefunded,
+			Paid:      a.inventoryService.HandleOrderPaid,
+			Shipped:   a.inventoryService.HandleOrderShipped,
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		a.inventoryService.RunExpirySweeper(ctx, reservationSweepInterval)
+	}()
 
 	go func() {
 		log.Printf("gRPC server listening at %v", a.listener.Addr())
@@ -73,4 +124,12 @@ func (a *App) Run(ctx context.Context) {
 	<-ctx.Done()
 	log.Println("shutting down server gracefully...")
 	a.server.GracefulStop()
+	wg.Wait()
+
+	if err := a.consClose(); err != nil {
+		log.Printf("failed to close kafka reader: %v", err)
+	}
+	if err := a.prodClose(); err != nil {
+		log.Printf("failed to close kafka writer: %v", err)
+	}
 }
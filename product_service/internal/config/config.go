@@ -2,7 +2,10 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/rs/zerolog/log"
@@ -14,8 +17,22 @@ type Config struct {
 	Addr               string
 	UserServiceAddress string
 	DBUrl              string
+	KafkaBrokers       []string
+	// InternalToken authenticates other services on internal-only RPCs.
+	InternalToken string
+	// KafkaMaxAttempts is how often an order event is tried before it is
+	// moved to the dead-letter topic.
+	KafkaMaxAttempts int
+	// OrderReservationTTL is how long stock stays reserved for an order that
+	// has not been paid.
+	OrderReservationTTL time.Duration
 }
 
+const (
+	defaultKafkaMaxAttempts    = 5
+	defaultOrderReservationTTL = time.Hour
+)
+
 var (
 	config *Config
 	once   sync.Once
@@ -30,13 +47,38 @@ func loadConfig() {
 	addr := os.Getenv("ADDR")
 	user_service_addr := os.Getenv("USER_SERVICE_ADDR")
 	dynamodbURl := os.Getenv("DYNAMO_DB_URL")
+	internalToken := os.Getenv("INTERNAL_API_TOKEN")
+	kafkaBrokers := os.Getenv("KAFKA_BROKERS")
+	if kafkaBrokers == "" {
+		kafkaBrokers = "localhost:9092"
+	}
+	kafkaMaxAttempts := defaultKafkaMaxAttempts
+	if raw := os.Getenv("KAFKA_MAX_ATTEMPTS"); raw != "" {
+		attempts, err := strconv.Atoi(raw)
+		if err != nil || attempts < 1 {
+			log.Fatal().Str("KAFKA_MAX_ATTEMPTS", raw).Msg("invalid kafka max attempts")
+		}
+		kafkaMaxAttempts = attempts
+	}
+	orderReservationTTL := defaultOrderReservationTTL
+	if raw := os.Getenv("ORDER_RESERVATION_TTL"); raw != "" {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil || ttl <= 0 {
+			log.Fatal().Str("ORDER_RESERVATION_TTL", raw).Msg("invalid order reservation ttl")
+		}
+		orderReservationTTL = ttl
+	}
 
 	config = &Config{
-		Version:            version,
-		ServiceName:        serviceName,
-		Addr:               addr,
-		UserServiceAddress: user_service_addr,
-		DBUrl:              dynamodbURl,
+		Version:             version,
+		ServiceName:         serviceName,
+		Addr:                addr,
+		UserServiceAddress:  user_service_addr,
+		DBUrl:               dynamodbURl,
+		KafkaBrokers:        strings.Split(kafkaBrokers, ","),
+		InternalToken:       internalToken,
+		KafkaMaxAttempts:    kafkaMaxAttempts,
+		OrderReservationTTL: orderReservationTTL,
 	}
 	validateMainConfig(config)
 }
@@ -2,6 +2,8 @@ package domain
 
 import "time"
 
+// Product stock is split into Stock, the units free to sell, and Reserved,
+// the units held for orders that have not completed yet.
 type Product struct {
 	ProductID     string    `json:"product_id" dynamodbav:"ProductID"`
 	Name          string    `json:"name" dynamodbav:"name"`
@@ -15,6 +17,13 @@ type Product struct {
 	Tags          []string  `json:"tags,omitempty" dynamodbav:"tags,omitempty"`
 	AverageRating float64   `json:"average_rating" dynamodbav:"average_rating"`
 	TotalReviews  int       `json:"total_reviews" dynamodbav:"total_reviews"`
+	Stock         int       `json:"stock" dynamodbav:"stock"`
+	Reserved      int       `json:"reserved" dynamodbav:"reserved"`
 	CreatedAt     time.Time `json:"created_at" dynamodbav:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at" dynamodbav:"updated_at"`
 }
+
+// OnHand is the number of units physically held, reserved or not.
+func (p *Product) OnHand() int {
+	return p.Stock + p.Reserved
+}
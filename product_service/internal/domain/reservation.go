@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+const (
+	ReservationStatusReserved = "reserved"
+	ReservationStatusReleased = "released"
+	ReservationStatusExpired  = "expired"
+	// ReservationStatusCancelled marks an order that was cancelled before it
+	// was reserved. It holds no stock and keeps the order from reserving any.
+	ReservationStatusCancelled = "cancelled"
+	// ReservationStatusFulfilled marks an order that shipped. Its units left
+	// the warehouse and are no longer counted as reserved.
+	ReservationStatusFulfilled = "fulfilled"
+)
+
+// Reservation holds the stock set aside for one order. It is keyed by order
+// id so the same order can never reserve twice.
+type Reservation struct {
+	OrderID   string            `json:"order_id" dynamodbav:"OrderID"`
+	Items     []ReservationItem `json:"items" dynamodbav:"items"`
+	Status    string            `json:"status" dynamodbav:"status"`
+	ExpiresAt int64             `json:"expires_at,omitempty" dynamodbav:"expires_at,omitempty"` // unix seconds, 0 never expires
+	// ReturnedRefunds lists the refunds whose units went back to stock.
+	ReturnedRefunds []string  `json:"returned_refunds,omitempty" dynamodbav:"returned_refunds,stringset,omitempty"`
+	CreatedAt       time.Time `json:"created_at" dynamodbav:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" dynamodbav:"updated_at"`
+}
+
+type ReservationItem struct {
+	ProductID string `json:"product_id" dynamodbav:"product_id"`
+	Category  string `json:"category" dynamodbav:"category"`
+	Quantity  int    `json:"quantity" dynamodbav:"quantity"`
+}
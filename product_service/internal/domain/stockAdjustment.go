@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+const (
+	StockAdjustmentRestock    = "restock"
+	StockAdjustmentCorrection = "correction"
+)
+
+// StockAdjustment is the audit record written with every manual change to a
+// product's on-hand stock.
+type StockAdjustment struct {
+	ProductID      string    `json:"product_id" dynamodbav:"ProductID"`
+	AdjustmentID   string    `json:"adjustment_id" dynamodbav:"AdjustmentID"`
+	Category       string    `json:"category" dynamodbav:"category"`
+	Type           string    `json:"type" dynamodbav:"type"`
+	PreviousOnHand int       `json:"previous_on_hand" dynamodbav:"previous_on_hand"`
+	NewOnHand      int       `json:"new_on_hand" dynamodbav:"new_on_hand"`
+	Delta          int       `json:"delta" dynamodbav:"delta"`
+	Actor          string    `json:"actor" dynamodbav:"actor"`
+	Reason         string    `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	CreatedAt      time.Time `json:"created_at" dynamodbav:"created_at"`
+}
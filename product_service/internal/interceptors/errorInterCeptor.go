@@ -41,6 +41,8 @@ func grpcCodeToHTTP(code codes.Code) int {
 		return 404
 	case codes.AlreadyExists:
 		return 409
+	case codes.FailedPrecondition, codes.Aborted:
+		return 409
 	case codes.Internal:
 		return 500
 	default:
@@ -0,0 +1,44 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// InternalTokenHeader carries the shared secret other services send on
+// internal calls.
+const InternalTokenHeader = "x-internal-token"
+
+// staffRoles may call internal methods with their own identity.
+var staffRoles = []string{"admin", "superAdmin"}
+
+// InternalOnlyInterceptor restricts methods to other services, which present
+// token in InternalTokenHeader, and to staff signed in through the gateway.
+// An empty token leaves the methods to staff only.
+func InternalOnlyInterceptor(token string, methods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !slices.Contains(methods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		if token != "" {
+			for _, sent := range md.Get(InternalTokenHeader) {
+				if subtle.ConstantTimeCompare([]byte(sent), []byte(token)) == 1 {
+					return handler(ctx, req)
+				}
+			}
+		}
+		for _, role := range md.Get("x-user-role") {
+			if slices.Contains(staffRoles, role) {
+				return handler(ctx, req)
+			}
+		}
+		return nil, status.Error(codes.PermissionDenied, "this method is only available to internal callers")
+	}
+}
@@ -0,0 +1,230 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	productpb "product_service/proto/gen"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const retryBackoff = 2 * time.Second
+
+type consumer struct {
+	reader      *kafka.Reader
+	deadLetters *kafka.Writer
+	maxAttempts int
+	events      EventLog
+}
+
+// EventLog records the event ids a consumer group has processed.
+type EventLog interface {
+	Seen(ctx context.Context, consumerGroup, eventID string) (bool, error)
+	MarkProcessed(ctx context.Context, consumerGroup, eventID string) error
+}
+
+// OrderEventHandlers apply the order events to inventory. A handler that
+// fails is called again with the same event, so it has to tolerate partial
+// work from an earlier attempt.
+type OrderEventHandlers struct {
+	Created   func(ctx context.Context, event *productpb.OrderCreatedEvent) error
+	Cancelled func(ctx context.Context, event *productpb.OrderCancelledEvent) error
+	Refunded  func(ctx context.Context, event *productpb.OrderRefundedEvent) error
+	Paid      func(ctx context.Context, event *productpb.OrderPaidEvent) error
+	Shipped   func(ctx context.Context, event *productpb.OrderShippedEvent) error
+}
+
+type Consumer interface {
+	StartOrderListener(ctx context.Context, handlers OrderEventHandlers)
+}
+
+// NewConsumer returns a consumer that skips events already recorded in
+// events for the reader's group. An event that cannot be decoded, or whose
+// handler still fails after maxAttempts tries, is parked on the deadLetters
+// writer's topic so later events of the partition are not held up.
+func NewConsumer(reader *kafka.Reader, deadLetters *kafka.Writer, maxAttempts int, events EventLog) (Consumer, func() error) {
+	c := &consumer{
+		reader:      reader,
+		deadLetters: deadLetters,
+		maxAttempts: maxAttempts,
+		events:      events,
+	}
+	return c, func() error {
+		return errors.Join(c.reader.Close(), c.deadLetters.Close())
+	}
+}
+
+// StartOrderListener applies order events until ctx is cancelled. An offset
+// is committed only once its event reached inventory or the dead-letter
+// topic, so stock is never left reserved or released by an event that was
+// lost in a crash.
+func (c *consumer) StartOrderListener(ctx context.Context, handlers OrderEventHandlers) {
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Error fetching order event: %v", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryBackoff):
+			}
+			continue
+		}
+
+		attempts, err := c.applyOrderEvent(ctx, m, handlers)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && !c.park(ctx, m, err, attempts) {
+			return
+		}
+
+		if err := c.reader.CommitMessages(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Failed to commit offset %d: %v", m.Offset, err)
+		}
+	}
+}
+
+// applyOrderEvent decodes m and hands it to its handler, trying up to
+// c.maxAttempts times. It returns the attempts made and the error of the
+// last one. Undecodable events fail the same way every time and are given
+// up on straight away.
+func (c *consumer) applyOrderEvent(ctx context.Context, m kafka.Message, handlers OrderEventHandlers) (int, error) {
+	envelope, handle, err := decodeOrderEvent(m, handlers)
+	if err != nil {
+		return 1, err
+	}
+	eventCtx := WithCorrelationID(ctx, envelope.CorrelationId)
+	for attempt := 1; ; attempt++ {
+		err := c.handleOnce(eventCtx, envelope.EventId, handle)
+		if err == nil || attempt >= c.maxAttempts {
+			return attempt, err
+		}
+		log.Printf("Failed to apply %s event for order %s to inventory (attempt %d/%d): %v",
+			envelope.EventType, m.Key, attempt, c.maxAttempts, err)
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(retryBackoff):
+		}
+	}
+}
+
+// handleOnce skips events this group already applied to inventory and
+// records the id once handle succeeds. Bare events have no id to record.
+func (c *consumer) handleOnce(ctx context.Context, eventID string, handle func(ctx context.Context) error) error {
+	group := c.reader.Config().GroupID
+	if eventID != "" {
+		seen, err := c.events.Seen(ctx, group, eventID)
+		if err != nil {
+			return err
+		}
+		if seen {
+			log.Printf("Skipping already processed event %s", eventID)
+			return nil
+		}
+	}
+	if err := handle(ctx); err != nil {
+		return err
+	}
+	if eventID == "" {
+		return nil
+	}
+	return c.events.MarkProcessed(ctx, group, eventID)
+}
+
+// park copies m to the dead-letter topic with headers saying where it came
+// from and why it was given up on. Nothing else consumes that topic; an
+// operator republishes a parked event to the order events topic once its
+// cause is fixed. park keeps trying until the copy is written and reports
+// false only if ctx was cancelled first.
+func (c *consumer) park(ctx context.Context, m kafka.Message, cause error, attempts int) bool {
+	parked := kafka.Message{
+		Key:   m.Key,
+		Value: m.Value,
+		Headers: append(append([]kafka.Header{}, m.Headers...),
+			kafka.Header{Key: DeadLetterErrorHeader, Value: []byte(cause.Error())},
+			kafka.Header{Key: DeadLetterAttemptsHeader, Value: []byte(strconv.Itoa(attempts))},
+			kafka.Header{Key: DeadLetterFailedAtHeader, Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+			kafka.Header{Key: DeadLetterOriginalTopicHeader, Value: []byte(m.Topic)},
+			kafka.Header{Key: DeadLetterOriginalPartitionHeader, Value: []byte(strconv.Itoa(m.Partition))},
+			kafka.Header{Key: DeadLetterOriginalOffsetHeader, Value: []byte(strconv.FormatInt(m.Offset, 10))},
+		),
+	}
+	for {
+		err := c.deadLetters.WriteMessages(ctx, parked)
+		if err == nil {
+			log.Printf("Parked order event at offset %d on %s after %d attempts: %v", m.Offset, c.deadLetters.Topic, attempts, cause)
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		log.Printf("Failed to park order event at offset %d, retrying: %v", m.Offset, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryBackoff):
+		}
+	}
+}
+
+// decodeOrderEvent opens the envelope of m, unmarshals its payload by event
+// type and binds it to the matching handler.
+func decodeOrderEvent(m kafka.Message, handlers OrderEventHandlers) (*productpb.EventEnvelope, func(ctx context.Context) error, error) {
+	envelope, err := Open(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkSchema(envelope); err != nil {
+		return nil, nil, err
+	}
+
+	var handle func(ctx context.Context) error
+	switch t := envelope.EventType; t {
+	case EventTypeOrderCreated:
+		var event productpb.OrderCreatedEvent
+		if err := proto.Unmarshal(envelope.Payload, &event); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s event: %w", t, err)
+		}
+		handle = func(ctx context.Context) error { return handlers.Created(ctx, &event) }
+	case EventTypeOrderCancelled:
+		var event productpb.OrderCancelledEvent
+		if err := proto.Unmarshal(envelope.Payload, &event); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s event: %w", t, err)
+		}
+		handle = func(ctx context.Context) error { return handlers.Cancelled(ctx, &event) }
+	case EventTypeOrderRefunded:
+		var event productpb.OrderRefundedEvent
+		if err := proto.Unmarshal(envelope.Payload, &event); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s event: %w", t, err)
+		}
+		handle = func(ctx context.Context) error { return handlers.Refunded(ctx, &event) }
+	case EventTypeOrderPaid:
+		var event productpb.OrderPaidEvent
+		if err := proto.Unmarshal(envelope.Payload, &event); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s event: %w", t, err)
+		}
+		handle = func(ctx context.Context) error { return handlers.Paid(ctx, &event) }
+	case EventTypeOrderShipped:
+		var event productpb.OrderShippedEvent
+		if err := proto.Unmarshal(envelope.Payload, &event); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s event: %w", t, err)
+		}
+		handle = func(ctx context.Context) error { return handlers.Shipped(ctx, &event) }
+	default:
+		return nil, nil, fmt.Errorf("unknown event type %q", t)
+	}
+	return envelope, handle, nil
+}
@@ -0,0 +1,112 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	productpb "product_service/proto/gen"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The validation results this service publishes follow the envelope format
+// and payload schema shared with order_service.
+const (
+	SchemaVersion   = "1.0"
+	EnvelopeVersion = "1"
+	ProducerService = "product_service"
+)
+
+var (
+	ErrUnsupportedEnvelope = errors.New("unsupported event envelope version")
+	ErrUnsupportedSchema   = errors.New("unsupported event schema version")
+)
+
+type correlationKey struct{}
+
+// WithCorrelationID carries the correlation id of the event being handled so
+// events published in response share it.
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, correlationID)
+}
+
+// CorrelationID returns the id set by WithCorrelationID, or an empty string.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationKey{}).(string)
+	return id
+}
+
+// Seal envelopes a validation result for the order results topic.
+func Seal(eventType, correlationID string, event proto.Message, occurredAt time.Time) ([]byte, error) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+	envelope, err := proto.Marshal(&productpb.EventEnvelope{
+		EventId:         uuid.NewString(),
+		EventType:       eventType,
+		SchemaVersion:   SchemaVersion,
+		OccurredAt:      timestamppb.New(occurredAt),
+		CorrelationId:   correlationID,
+		Producer:        ProducerService,
+		Payload:         payload,
+		EnvelopeVersion: EnvelopeVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s envelope: %w", eventType, err)
+	}
+	return envelope, nil
+}
+
+// Open unwraps an order event. Bare events from before order_service sealed
+// them get no event id and, lacking an event type header, count as order
+// created events.
+func Open(m kafka.Message) (*productpb.EventEnvelope, error) {
+	if header(m, EnvelopeHeader) == "" {
+		eventType := header(m, EventTypeHeader)
+		if eventType == "" {
+			eventType = EventTypeOrderCreated
+		}
+		return &productpb.EventEnvelope{
+			EventType:     eventType,
+			SchemaVersion: SchemaVersion,
+			Payload:       m.Value,
+		}, nil
+	}
+
+	var envelope productpb.EventEnvelope
+	if err := proto.Unmarshal(m.Value, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event envelope: %w", err)
+	}
+	version := envelope.EnvelopeVersion
+	if version == "" {
+		version = header(m, EnvelopeHeader)
+	}
+	if version != EnvelopeVersion {
+		return nil, fmt.Errorf("%w %q for %s event %s", ErrUnsupportedEnvelope, version, envelope.EventType, envelope.EventId)
+	}
+	return &envelope, nil
+}
+
+// checkSchema rejects payloads of a major version this build cannot decode.
+func checkSchema(envelope *productpb.EventEnvelope) error {
+	major, _, _ := strings.Cut(envelope.SchemaVersion, ".")
+	if supported, _, _ := strings.Cut(SchemaVersion, "."); major != supported {
+		return fmt.Errorf("%w %q for %s event %s", ErrUnsupportedSchema, envelope.SchemaVersion, envelope.EventType, envelope.EventId)
+	}
+	return nil
+}
+
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,43 @@
+package kafka
+
+import (
+	"context"
+	productpb "product_service/proto/gen"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type producer struct {
+	writer *kafka.Writer
+}
+
+type Producer interface {
+	PublishValidationResult(ctx context.Context, result *productpb.OrderValidationResultEvent) error
+}
+
+func NewProducer(writer *kafka.Writer) (Producer, func() error) {
+	p := &producer{writer: writer}
+	return p, p.writer.Close
+}
+
+// PublishValidationResult sends the verdict in an envelope that carries the
+// correlation id of the order event being handled.
+func (p *producer) PublishValidationResult(ctx context.Context, result *productpb.OrderValidationResultEvent) error {
+	correlationID := CorrelationID(ctx)
+	if correlationID == "" {
+		correlationID = result.OrderId
+	}
+	value, err := Seal(EventTypeOrderValidationResult, correlationID, result, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(result.OrderId),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: EventTypeHeader, Value: []byte(EventTypeOrderValidationResult)},
+			{Key: EnvelopeHeader, Value: []byte(EnvelopeVersion)},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package kafka
+
+const (
+	OrderEventsTopic = "order-events"
+	// OrderEventsDeadLetterTopic holds order events inventory gave up on.
+	OrderEventsDeadLetterTopic = "order-events-dlq"
+	OrderResultsTopic          = "order-results"
+)
+
+// EventTypeHeader names the kind of event carried by a message. The order
+// event values match order_service's outbox event types. EnvelopeHeader is
+// set on messages whose value is an EventEnvelope.
+const (
+	EventTypeHeader                = "event-type"
+	EnvelopeHeader                 = "envelope"
+	EventTypeOrderValidationResult = "OrderValidationResult"
+	EventTypeOrderCreated          = "OrderCreated"
+	EventTypeOrderCancelled        = "OrderCancelled"
+	EventTypeOrderRefunded         = "OrderRefunded"
+	EventTypeOrderPaid             = "OrderPaid"
+	EventTypeOrderShipped          = "OrderShipped"
+)
+
+// Dead-letter headers are added to a parked message next to its original
+// headers. They use the same names as order_service's dead letters.
+const (
+	DeadLetterErrorHeader             = "dlq-error"
+	DeadLetterAttemptsHeader          = "dlq-attempts"
+	DeadLetterFailedAtHeader          = "dlq-failed-at"
+	DeadLetterOriginalTopicHeader     = "dlq-original-topic"
+	DeadLetterOriginalPartitionHeader = "dlq-original-partition"
+	DeadLetterOriginalOffsetHeader    = "dlq-original-offset"
+)
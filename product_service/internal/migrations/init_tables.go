@@ -37,3 +37,143 @@ func InitProductTable(client *dynamodb.Client) {
 
 	log.Println("Table created successfully:", tableName)
 }
+
+// reservationExpiryIndex lets the expiry sweeper query active reservations by
+// expiry instead of scanning the table. Reservations without an expiry have
+// no expires_at and stay out of the index.
+var reservationExpiryIndex = types.GlobalSecondaryIndex{
+	IndexName: aws.String("StatusExpiresAtIndex"),
+	KeySchema: []types.KeySchemaElement{
+		{AttributeName: aws.String("status"), KeyType: types.KeyTypeHash},
+		{AttributeName: aws.String("expires_at"), KeyType: types.KeyTypeRange},
+	},
+	Projection: &types.Projection{ProjectionType: types.ProjectionTypeAll},
+}
+
+func InitReservationTable(client *dynamodb.Client) {
+	tableName := "Reservations"
+
+	attributes := []types.AttributeDefinition{
+		{AttributeName: aws.String("OrderID"), AttributeType: types.ScalarAttributeTypeS},
+		{AttributeName: aws.String("status"), AttributeType: types.ScalarAttributeTypeS},
+		{AttributeName: aws.String("expires_at"), AttributeType: types.ScalarAttributeTypeN},
+	}
+	_, err := client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName:            &tableName,
+		AttributeDefinitions: attributes,
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("OrderID"), KeyType: types.KeyTypeHash}, // Partition Key
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{reservationExpiryIndex},
+		BillingMode:            types.BillingModePayPerRequest,
+	})
+
+	if err != nil {
+		var exists *types.ResourceInUseException
+		if errors.As(err, &exists) {
+			log.Println("Table already exists:", tableName)
+			addReservationExpiryIndex(client, tableName, attributes)
+			return
+		}
+		log.Fatal("Failed to create table:", err)
+	}
+
+	log.Println("Table created successfully:", tableName)
+}
+
+// addReservationExpiryIndex adds the expiry index to a table created before
+// it existed. DynamoDB builds it in the background.
+func addReservationExpiryIndex(client *dynamodb.Client, tableName string, attributes []types.AttributeDefinition) {
+	table, err := client.DescribeTable(context.TODO(), &dynamodb.DescribeTableInput{TableName: &tableName})
+	if err != nil {
+		log.Fatal("Failed to describe table:", err)
+	}
+	for _, index := range table.Table.GlobalSecondaryIndexes {
+		if aws.ToString(index.IndexName) == aws.ToString(reservationExpiryIndex.IndexName) {
+			return
+		}
+	}
+
+	_, err = client.UpdateTable(context.TODO(), &dynamodb.UpdateTableInput{
+		TableName:            &tableName,
+		AttributeDefinitions: attributes,
+		GlobalSecondaryIndexUpdates: []types.GlobalSecondaryIndexUpdate{{
+			Create: &types.CreateGlobalSecondaryIndexAction{
+				IndexName:  reservationExpiryIndex.IndexName,
+				KeySchema:  reservationExpiryIndex.KeySchema,
+				Projection: reservationExpiryIndex.Projection,
+			},
+		}},
+	})
+	if err != nil {
+		log.Fatal("Failed to add expiry index:", err)
+	}
+	log.Println("Expiry index added to", tableName)
+}
+
+func InitStockAdjustmentTable(client *dynamodb.Client) {
+	tableName := "StockAdjustments"
+
+	_, err := client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName: &tableName,
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("ProductID"), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String("AdjustmentID"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("ProductID"), KeyType: types.KeyTypeHash},     // Partition Key
+			{AttributeName: aws.String("AdjustmentID"), KeyType: types.KeyTypeRange}, // Sort Key (time ordered)
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+
+	if err != nil {
+		var exists *types.ResourceInUseException
+		if errors.As(err, &exists) {
+			log.Println("Table already exists:", tableName)
+			return
+		}
+		log.Fatal("Failed to create table:", err)
+	}
+
+	log.Println("Table created successfully:", tableName)
+}
+
+func InitProcessedEventTable(client *dynamodb.Client) {
+	tableName := "ProcessedEvents"
+
+	_, err := client.CreateTable(context.TODO(), &dynamodb.CreateTableInput{
+		TableName: &tableName,
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String("EventKey"), AttributeType: types.ScalarAttributeTypeS},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("EventKey"), KeyType: types.KeyTypeHash}, // Partition Key (group#event id)
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	})
+
+	if err != nil {
+		var exists *types.ResourceInUseException
+		if errors.As(err, &exists) {
+			log.Println("Table already exists:", tableName)
+			return
+		}
+		log.Fatal("Failed to create table:", err)
+	}
+
+	// Old event ids only need to outlive redeliveries, so let DynamoDB expire
+	// them instead of sweeping the table.
+	_, err = client.UpdateTimeToLive(context.TODO(), &dynamodb.UpdateTimeToLiveInput{
+		TableName: &tableName,
+		TimeToLiveSpecification: &types.TimeToLiveSpecification{
+			AttributeName: aws.String("expires_at"),
+			Enabled:       aws.Bool(true),
+		},
+	})
+	if err != nil {
+		log.Println("Failed to enable ttl on", tableName, err)
+	}
+
+	log.Println("Table created successfully:", tableName)
+}
@@ -0,0 +1,607 @@
+package inventoryrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"product_service/internal/domain"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// MaxReservationItems keeps a reservation within DynamoDB's 100 action
+// transaction limit (one action is the reservation record itself).
+const MaxReservationItems = 99
+
+var (
+	ErrAlreadyReserved     = errors.New("order already reserved")
+	ErrReservationNotFound = errors.New("reservation not found")
+	// ErrReservationNotActive means the reservation was already released or
+	// expired, so its stock is no longer held.
+	ErrReservationNotActive = errors.New("reservation is not active")
+	// ErrStockChanged means the product's stock moved after it was read.
+	ErrStockChanged = errors.New("stock changed concurrently")
+)
+
+// StockError reports the products whose conditional stock check failed.
+type StockError struct {
+	ProductIDs []string
+}
+
+func (e *StockError) Error() string {
+	return fmt.Sprintf("insufficient stock for products %v", e.ProductIDs)
+}
+
+type inventoryRepo struct {
+	client           *dynamodb.Client
+	productTable     string
+	reservationTable string
+	adjustmentTable  string
+}
+
+type InventoryRepo interface {
+	Reserve(ctx context.Context, reservation *domain.Reservation) error
+	GetReservation(ctx context.Context, orderID string) (*domain.Reservation, error)
+	// Tombstone records a cancelled order that has no reservation yet, so a
+	// later Reserve for it fails with ErrAlreadyReserved. It fails with
+	// ErrAlreadyReserved itself if the order was reserved in the meantime.
+	Tombstone(ctx context.Context, reservation *domain.Reservation) error
+	Release(ctx context.Context, reservation *domain.Reservation, status string) error
+	// Keep holds the stock of a paid order until it ships. A reserved order
+	// loses its expiry; an expired one reserves its stock again, failing with
+	// a StockError if some of it is gone.
+	Keep(ctx context.Context, reservation *domain.Reservation) error
+	// Fulfil marks the reservation of a shipped order fulfilled and takes its
+	// units off hand.
+	Fulfil(ctx context.Context, reservation *domain.Reservation) error
+	ListExpired(ctx context.Context, now time.Time) ([]*domain.Reservation, error)
+	ReturnStock(ctx context.Context, reservation *domain.Reservation, refundID string, items []domain.ReservationItem) error
+	AdjustStock(ctx context.Context, product *domain.Product, adjustment *domain.StockAdjustment) error
+	ListAdjustments(ctx context.Context, productID string, limit int) ([]*domain.StockAdjustment, error)
+}
+
+func NewRepo(client *dynamodb.Client, productTable, reservationTable, adjustmentTable string) InventoryRepo {
+	return &inventoryRepo{
+		client:           client,
+		productTable:     productTable,
+		reservationTable: reservationTable,
+		adjustmentTable:  adjustmentTable,
+	}
+}
+
+// Reserve atomically records the reservation and moves the quantity of every
+// item from stock to reserved. Nothing is written unless every product has
+// enough stock and the order has not been reserved before.
+func (r *inventoryRepo) Reserve(ctx context.Context, reservation *domain.Reservation) error {
+	if len(reservation.Items) > MaxReservationItems {
+		return fmt.Errorf("reservation has %d items, at most %d are supported", len(reservation.Items), MaxReservationItems)
+	}
+
+	av, err := attributevalue.MarshalMap(reservation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reservation: %w", err)
+	}
+
+	actions := make([]types.TransactWriteItem, 0, len(reservation.Items)+1)
+	actions = append(actions, types.TransactWriteItem{
+		Put: &types.Put{
+			TableName:           aws.String(r.reservationTable),
+			Item:                av,
+			ConditionExpression: aws.String("attribute_not_exists(OrderID)"),
+		},
+	})
+	for _, item := range reservation.Items {
+		actions = append(actions, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:           aws.String(r.productTable),
+				Key:                 productKey(item.Category, item.ProductID),
+				UpdateExpression:    aws.String("SET stock = stock - :qty, reserved = if_not_exists(reserved, :zero) + :qty"),
+				ConditionExpression: aws.String("attribute_exists(ProductID) AND stock >= :qty"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":qty":  numberValue(item.Quantity),
+					":zero": numberValue(0),
+				},
+			},
+		})
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: actions,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *types.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return fmt.Errorf("failed to reserve stock: %w", err)
+	}
+	// Cancellation reasons are positional: index 0 is the reservation record,
+	// index i+1 is reservation.Items[i].
+	stockErr := &StockError{}
+	for i, reason := range canceled.CancellationReasons {
+		if aws.ToString(reason.Code) != "ConditionalCheckFailed" {
+			continue
+		}
+		if i == 0 {
+			return ErrAlreadyReserved
+		}
+		stockErr.ProductIDs = append(stockErr.ProductIDs, reservation.Items[i-1].ProductID)
+	}
+	if len(stockErr.ProductIDs) > 0 {
+		return stockErr
+	}
+	return fmt.Errorf("failed to reserve stock: %w", err)
+}
+
+func (r *inventoryRepo) GetReservation(ctx context.Context, orderID string) (*domain.Reservation, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.reservationTable),
+		Key: map[string]types.AttributeValue{
+			"OrderID": &types.AttributeValueMemberS{Value: orderID},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+	if result.Item == nil {
+		return nil, ErrReservationNotFound
+	}
+
+	var reservation domain.Reservation
+	if err := attributevalue.UnmarshalMap(result.Item, &reservation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reservation: %w", err)
+	}
+	return &reservation, nil
+}
+
+func (r *inventoryRepo) Tombstone(ctx context.Context, reservation *domain.Reservation) error {
+	av, err := attributevalue.MarshalMap(reservation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reservation: %w", err)
+	}
+	_, err = r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(r.reservationTable),
+		Item:                av,
+		ConditionExpression: aws.String("attribute_not_exists(OrderID)"),
+	})
+	var failed *types.ConditionalCheckFailedException
+	if errors.As(err, &failed) {
+		return ErrAlreadyReserved
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record cancelled order: %w", err)
+	}
+	return nil
+}
+
+// Release moves the reservation to status and returns its quantities from
+// reserved to stock in one transaction. It fails with ErrReservationNotActive
+// when the reservation no longer holds stock, or when it is to expire but its
+// expiry changed since it was read, e.g. because the order was paid.
+func (r *inventoryRepo) Release(ctx context.Context, reservation *domain.Reservation, status string) error {
+	now, err := attributevalue.Marshal(time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to marshal updated_at: %w", err)
+	}
+
+	condition := "#status = :reserved"
+	values := map[string]types.AttributeValue{
+		":status":   &types.AttributeValueMemberS{Value: status},
+		":reserved": &types.AttributeValueMemberS{Value: domain.ReservationStatusReserved},
+		":now":      now,
+	}
+	if status == domain.ReservationStatusExpired {
+		condition += " AND expires_at = :expires_at"
+		values[":expires_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(reservation.ExpiresAt, 10)}
+	}
+	actions := make([]types.TransactWriteItem, 0, len(reservation.Items)+1)
+	actions = append(actions, types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: aws.String(r.reservationTable),
+			Key: map[string]types.AttributeValue{
+				"OrderID": &types.AttributeValueMemberS{Value: reservation.OrderID},
+			},
+			UpdateExpression:          aws.String("SET #status = :status, updated_at = :now"),
+			ConditionExpression:       aws.String(condition),
+			ExpressionAttributeNames:  map[string]string{"#status": "status"},
+			ExpressionAttributeValues: values,
+		},
+	})
+	for _, item := range reservation.Items {
+		actions = append(actions, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:           aws.String(r.productTable),
+				Key:                 productKey(item.Category, item.ProductID),
+				UpdateExpression:    aws.String("SET stock = stock + :qty, reserved = reserved - :qty"),
+				ConditionExpression: aws.String("attribute_exists(ProductID)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":qty": numberValue(item.Quantity),
+				},
+			},
+		})
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: actions,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *types.TransactionCanceledException
+	if errors.As(err, &canceled) && len(canceled.CancellationReasons) > 0 &&
+		aws.ToString(canceled.CancellationReasons[0].Code) == "ConditionalCheckFailed" {
+		return ErrReservationNotActive
+	}
+	return fmt.Errorf("failed to release reservation: %w", err)
+}
+
+func (r *inventoryRepo) Keep(ctx context.Context, reservation *domain.Reservation) error {
+	now, err := attributevalue.Marshal(time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to marshal updated_at: %w", err)
+	}
+
+	if reservation.Status == domain.ReservationStatusReserved {
+		_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+			TableName: aws.String(r.reservationTable),
+			Key: map[string]types.AttributeValue{
+				"OrderID": &types.AttributeValueMemberS{Value: reservation.OrderID},
+			},
+			UpdateExpression:         aws.String("SET updated_at = :now REMOVE expires_at"),
+			ConditionExpression:      aws.String("#status = :reserved"),
+			ExpressionAttributeNames: map[string]string{"#status": "status"},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":reserved": &types.AttributeValueMemberS{Value: domain.ReservationStatusReserved},
+				":now":      now,
+			},
+		})
+		var failed *types.ConditionalCheckFailedException
+		if errors.As(err, &failed) {
+			return ErrReservationNotActive
+		}
+		if err != nil {
+			return fmt.Errorf("failed to keep reservation: %w", err)
+		}
+		return nil
+	}
+
+	actions := make([]types.TransactWriteItem, 0, len(reservation.Items)+1)
+	actions = append(actions, types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: aws.String(r.reservationTable),
+			Key: map[string]types.AttributeValue{
+				"OrderID": &types.AttributeValueMemberS{Value: reservation.OrderID},
+			},
+			UpdateExpression:         aws.String("SET #status = :reserved, updated_at = :now REMOVE expires_at"),
+			ConditionExpression:      aws.String("#status = :expired"),
+			ExpressionAttributeNames: map[string]string{"#status": "status"},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":reserved": &types.AttributeValueMemberS{Value: domain.ReservationStatusReserved},
+				":expired":  &types.AttributeValueMemberS{Value: domain.ReservationStatusExpired},
+				":now":      now,
+			},
+		},
+	})
+	for _, item := range reservation.Items {
+		actions = append(actions, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:           aws.String(r.productTable),
+				Key:                 productKey(item.Category, item.ProductID),
+				UpdateExpression:    aws.String("SET stock = stock - :qty, reserved = if_not_exists(reserved, :zero) + :qty"),
+				ConditionExpression: aws.String("attribute_exists(ProductID) AND stock >= :qty"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":qty":  numberValue(item.Quantity),
+					":zero": numberValue(0),
+				},
+			},
+		})
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: actions,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *types.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return fmt.Errorf("failed to reserve expired stock again: %w", err)
+	}
+	stockErr := &StockError{}
+	for i, reason := range canceled.CancellationReasons {
+		if aws.ToString(reason.Code) != "ConditionalCheckFailed" {
+			continue
+		}
+		if i == 0 {
+			return ErrReservationNotActive
+		}
+		stockErr.ProductIDs = append(stockErr.ProductIDs, reservation.Items[i-1].ProductID)
+	}
+	if len(stockErr.ProductIDs) > 0 {
+		return stockErr
+	}
+	return fmt.Errorf("failed to reserve expired stock again: %w", err)
+}
+
+func (r *inventoryRepo) Fulfil(ctx context.Context, reservation *domain.Reservation) error {
+	now, err := attributevalue.Marshal(time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to marshal updated_at: %w", err)
+	}
+
+	actions := make([]types.TransactWriteItem, 0, len(reservation.Items)+1)
+	actions = append(actions, types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: aws.String(r.reservationTable),
+			Key: map[string]types.AttributeValue{
+				"OrderID": &types.AttributeValueMemberS{Value: reservation.OrderID},
+			},
+			UpdateExpression:         aws.String("SET #status = :fulfilled, updated_at = :now REMOVE expires_at"),
+			ConditionExpression:      aws.String("#status = :reserved"),
+			ExpressionAttributeNames: map[string]string{"#status": "status"},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":fulfilled": &types.AttributeValueMemberS{Value: domain.ReservationStatusFulfilled},
+				":reserved":  &types.AttributeValueMemberS{Value: domain.ReservationStatusReserved},
+				":now":       now,
+			},
+		},
+	})
+	for _, item := range reservation.Items {
+		// Refunds before shipping already gave some units back.
+		if item.Quantity == 0 {
+			continue
+		}
+		actions = append(actions, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:           aws.String(r.productTable),
+				Key:                 productKey(item.Category, item.ProductID),
+				UpdateExpression:    aws.String("SET reserved = reserved - :qty"),
+				ConditionExpression: aws.String("attribute_exists(ProductID)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":qty": numberValue(item.Quantity),
+				},
+			},
+		})
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: actions,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *types.TransactionCanceledException
+	if errors.As(err, &canceled) && len(canceled.CancellationReasons) > 0 &&
+		aws.ToString(canceled.CancellationReasons[0].Code) == "ConditionalCheckFailed" {
+		return ErrReservationNotActive
+	}
+	return fmt.Errorf("failed to fulfil reservation: %w", err)
+}
+
+// ReturnStock moves refunded units of an active reservation back from
+// reserved to stock and shrinks the reservation accordingly. The refund id is
+// recorded on the reservation so each refund is returned once; a repeated or
+// inactive return fails with ErrReservationNotActive.
+func (r *inventoryRepo) ReturnStock(ctx context.Context, reservation *domain.Reservation, refundID string, items []domain.ReservationItem) error {
+	now, err := attributevalue.Marshal(time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to marshal updated_at: %w", err)
+	}
+
+	sets := []string{"updated_at = :now"}
+	conditions := []string{
+		"#status = :reserved",
+		"(attribute_not_exists(returned_refunds) OR NOT contains(returned_refunds, :refund_id))",
+	}
+	values := map[string]types.AttributeValue{
+		":reserved":   &types.AttributeValueMemberS{Value: domain.ReservationStatusReserved},
+		":refund_id":  &types.AttributeValueMemberS{Value: refundID},
+		":refund_set": &types.AttributeValueMemberSS{Value: []string{refundID}},
+		":now":        now,
+	}
+	actions := make([]types.TransactWriteItem, 0, len(items)+1)
+	actions = append(actions, types.TransactWriteItem{})
+	for _, item := range items {
+		index := -1
+		for i, reserved := range reservation.Items {
+			if reserved.ProductID == item.ProductID && reserved.Category == item.Category {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return fmt.Errorf("product %s is not part of reservation %s", item.ProductID, reservation.OrderID)
+		}
+		qty := fmt.Sprintf(":qty_%d", index)
+		sets = append(sets, fmt.Sprintf("items[%d].quantity = items[%d].quantity - %s", index, index, qty))
+		conditions = append(conditions, fmt.Sprintf("items[%d].quantity >= %s", index, qty))
+		values[qty] = numberValue(item.Quantity)
+
+		actions = append(actions, types.TransactWriteItem{
+			Update: &types.Update{
+				TableName:           aws.String(r.productTable),
+				Key:                 productKey(item.Category, item.ProductID),
+				UpdateExpression:    aws.String("SET stock = stock + :qty, reserved = reserved - :qty"),
+				ConditionExpression: aws.String("attribute_exists(ProductID)"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":qty": numberValue(item.Quantity),
+				},
+			},
+		})
+	}
+	actions[0] = types.TransactWriteItem{
+		Update: &types.Update{
+			TableName: aws.String(r.reservationTable),
+			Key: map[string]types.AttributeValue{
+				"OrderID": &types.AttributeValueMemberS{Value: reservation.OrderID},
+			},
+			UpdateExpression:          aws.String("SET " + strings.Join(sets, ", ") + " ADD returned_refunds :refund_set"),
+			ConditionExpression:       aws.String(strings.Join(conditions, " AND ")),
+			ExpressionAttributeNames:  map[string]string{"#status": "status"},
+			ExpressionAttributeValues: values,
+		},
+	}
+
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: actions,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *types.TransactionCanceledException
+	if errors.As(err, &canceled) && len(canceled.CancellationReasons) > 0 &&
+		aws.ToString(canceled.CancellationReasons[0].Code) == "ConditionalCheckFailed" {
+		return ErrReservationNotActive
+	}
+	return fmt.Errorf("failed to return stock: %w", err)
+}
+
+// reservationExpiryIndex is the Reservations index keyed by status and
+// expires_at, see migrations.InitReservationTable.
+const reservationExpiryIndex = "StatusExpiresAtIndex"
+
+// ListExpired returns active reservations whose expiry is at or before now.
+// Reservations without an expiry are not in the index and never returned.
+func (r *inventoryRepo) ListExpired(ctx context.Context, now time.Time) ([]*domain.Reservation, error) {
+	input := &dynamodb.QueryInput{
+		TableName:                aws.String(r.reservationTable),
+		IndexName:                aws.String(reservationExpiryIndex),
+		KeyConditionExpression:   aws.String("#status = :reserved AND expires_at <= :now"),
+		ExpressionAttributeNames: map[string]string{"#status": "status"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":reserved": &types.AttributeValueMemberS{Value: domain.ReservationStatusReserved},
+			":now":      &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Unix(), 10)},
+		},
+	}
+
+	var reservations []*domain.Reservation
+	for {
+		result, err := r.client.Query(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query expired reservations: %w", err)
+		}
+		for _, item := range result.Items {
+			var reservation domain.Reservation
+			if err := attributevalue.UnmarshalMap(item, &reservation); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal reservation: %w", err)
+			}
+			reservations = append(reservations, &reservation)
+		}
+		if len(result.LastEvaluatedKey) == 0 {
+			return reservations, nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// AdjustStock writes the product's new stock and its audit record together.
+// product must be the state the adjustment was computed from; the write fails
+// with ErrStockChanged if stock or reserved moved since it was read.
+func (r *inventoryRepo) AdjustStock(ctx context.Context, product *domain.Product, adjustment *domain.StockAdjustment) error {
+	av, err := attributevalue.MarshalMap(adjustment)
+	if err != nil {
+		return fmt.Errorf("failed to marshal stock adjustment: %w", err)
+	}
+	now, err := attributevalue.Marshal(adjustment.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal updated_at: %w", err)
+	}
+
+	newStock := adjustment.NewOnHand - product.Reserved
+	_, err = r.client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{
+				Update: &types.Update{
+					TableName:        aws.String(r.productTable),
+					Key:              productKey(product.Category, product.ProductID),
+					UpdateExpression: aws.String("SET stock = :new_stock, reserved = if_not_exists(reserved, :zero), updated_at = :now"),
+					ConditionExpression: aws.String("attribute_exists(ProductID) AND " +
+						matchCounter("stock", ":stock", product.Stock) + " AND " +
+						matchCounter("reserved", ":reserved", product.Reserved)),
+					ExpressionAttributeValues: map[string]types.AttributeValue{
+						":new_stock": numberValue(newStock),
+						":stock":     numberValue(product.Stock),
+						":reserved":  numberValue(product.Reserved),
+						":zero":      numberValue(0),
+						":now":       now,
+					},
+				},
+			},
+			{
+				Put: &types.Put{
+					TableName:           aws.String(r.adjustmentTable),
+					Item:                av,
+					ConditionExpression: aws.String("attribute_not_exists(AdjustmentID)"),
+				},
+			},
+		},
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *types.TransactionCanceledException
+	if errors.As(err, &canceled) {
+		return ErrStockChanged
+	}
+	return fmt.Errorf("failed to adjust stock: %w", err)
+}
+
+// ListAdjustments returns the newest adjustments for a product first.
+func (r *inventoryRepo) ListAdjustments(ctx context.Context, productID string, limit int) ([]*domain.StockAdjustment, error) {
+	result, err := r.client.Query(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(r.adjustmentTable),
+		KeyConditionExpression: aws.String("ProductID = :product_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":product_id": &types.AttributeValueMemberS{Value: productID},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(int32(limit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stock adjustments: %w", err)
+	}
+
+	adjustments := make([]*domain.StockAdjustment, 0, len(result.Items))
+	for _, item := range result.Items {
+		var adjustment domain.StockAdjustment
+		if err := attributevalue.UnmarshalMap(item, &adjustment); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal stock adjustment: %w", err)
+		}
+		adjustments = append(adjustments, &adjustment)
+	}
+	return adjustments, nil
+}
+
+func productKey(category, productID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"Category":  &types.AttributeValueMemberS{Value: category},
+		"ProductID": &types.AttributeValueMemberS{Value: productID},
+	}
+}
+
+func numberValue(n int) types.AttributeValue {
+	return &types.AttributeValueMemberN{Value: strconv.Itoa(n)}
+}
+
+// matchCounter builds a condition that the counter attribute still equals the
+// value read. Products created before stock tracking have no counter at all,
+// which reads as zero.
+func matchCounter(attr, placeholder string, value int) string {
+	if value == 0 {
+		return fmt.Sprintf("(attribute_not_exists(%s) OR %s = %s)", attr, attr, placeholder)
+	}
+	return fmt.Sprintf("%s = %s", attr, placeholder)
+}
@@ -0,0 +1,69 @@
+package processedeventrepo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// retention is how long a processed event id is remembered; DynamoDB's TTL
+// removes the record afterwards.
+const retention = 7 * 24 * time.Hour
+
+type processedEventRepo struct {
+	client    *dynamodb.Client
+	tableName string
+}
+
+// ProcessedEventRepo remembers which events each consumer group has
+// processed so redelivered events can be skipped.
+type ProcessedEventRepo interface {
+	Seen(ctx context.Context, consumerGroup, eventID string) (bool, error)
+	MarkProcessed(ctx context.Context, consumerGroup, eventID string) error
+}
+
+func NewRepo(client *dynamodb.Client, tableName string) ProcessedEventRepo {
+	return &processedEventRepo{
+		client:    client,
+		tableName: tableName,
+	}
+}
+
+func (r *processedEventRepo) Seen(ctx context.Context, consumerGroup, eventID string) (bool, error) {
+	result, err := r.client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(r.tableName),
+		Key:            eventKey(consumerGroup, eventID),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check processed event: %w", err)
+	}
+	return result.Item != nil, nil
+}
+
+func (r *processedEventRepo) MarkProcessed(ctx context.Context, consumerGroup, eventID string) error {
+	now := time.Now().UTC()
+	item := eventKey(consumerGroup, eventID)
+	item["processed_at"] = &types.AttributeValueMemberS{Value: now.Format(time.RFC3339Nano)}
+	item["expires_at"] = &types.AttributeValueMemberN{Value: strconv.FormatInt(now.Add(retention).Unix(), 10)}
+
+	_, err := r.client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(r.tableName),
+		Item:      item,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark event processed: %w", err)
+	}
+	return nil
+}
+
+func eventKey(consumerGroup, eventID string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		"EventKey": &types.AttributeValueMemberS{Value: consumerGroup + "#" + eventID},
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
 
+var ErrProductNotFound = errors.New("product not found")
+
 type productRepo struct {
 	client    *dynamodb.Client
 	tableName string
@@ -23,10 +25,27 @@ type FilterOptions struct {
 	Search   string
 }
 
+// ProductKey is the primary key of a product.
+type ProductKey struct {
+	ProductID string
+	Category  string
+}
+
+const (
+	// batchGetLimit is the most keys DynamoDB accepts in one BatchGetItem.
+	batchGetLimit = 100
+	// maxBatchGetAttempts bounds how often keys DynamoDB left unprocessed,
+	// e.g. when throttled, are requested again.
+	maxBatchGetAttempts = 5
+)
+
 type ProductRepo interface {
 	Create(ctx context.Context, product *domain.Product) error
 	GetAll(ctx context.Context, filters *FilterOptions) ([]*domain.Product, int, error)
 	GetById(ctx context.Context, productId, category string) (*domain.Product, error)
+	// GetByIds returns the products with the given keys in no particular
+	// order. Keys without a product are skipped.
+	GetByIds(ctx context.Context, keys []ProductKey) ([]*domain.Product, error)
 	Update(ctx context.Context, productId, category string, updates map[string]interface{}) (*domain.Product, error)
 	Delete(ctx context.Context, productId, category string) (*domain.Product, error)
 }
@@ -121,7 +140,7 @@ func (r *productRepo) GetById(ctx context.Context, productId, category string) (
 		return nil, fmt.Errorf("failed to get item: %w", err)
 	}
 	if result.Item == nil {
-		return nil, fmt.Errorf("product not found")
+		return nil, ErrProductNotFound
 	}
 	var product domain.Product
 	if err := attributevalue.UnmarshalMap(result.Item, &product); err != nil {
@@ -131,6 +150,55 @@ func (r *productRepo) GetById(ctx context.Context, productId, category string) (
 
 }
 
+func (r *productRepo) GetByIds(ctx context.Context, keys []ProductKey) ([]*domain.Product, error) {
+	// BatchGetItem rejects requests that repeat a key.
+	seen := make(map[ProductKey]bool, len(keys))
+	requestKeys := make([]map[string]types.AttributeValue, 0, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		requestKeys = append(requestKeys, map[string]types.AttributeValue{
+			"Category":  &types.AttributeValueMemberS{Value: key.Category},
+			"ProductID": &types.AttributeValueMemberS{Value: key.ProductID},
+		})
+	}
+
+	products := make([]*domain.Product, 0, len(requestKeys))
+	for start := 0; start < len(requestKeys); start += batchGetLimit {
+		end := min(start+batchGetLimit, len(requestKeys))
+		pending := map[string]types.KeysAndAttributes{
+			r.tableName: {Keys: requestKeys[start:end]},
+		}
+		for attempt := 1; len(pending) > 0; attempt++ {
+			if attempt > maxBatchGetAttempts {
+				return nil, fmt.Errorf("failed to batch get items: keys still unprocessed after %d attempts", maxBatchGetAttempts)
+			}
+			if attempt > 1 {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(time.Duration(attempt) * 50 * time.Millisecond):
+				}
+			}
+			result, err := r.client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{RequestItems: pending})
+			if err != nil {
+				return nil, fmt.Errorf("failed to batch get items: %w", err)
+			}
+			for _, item := range result.Responses[r.tableName] {
+				var product domain.Product
+				if err := attributevalue.UnmarshalMap(item, &product); err != nil {
+					return nil, fmt.Errorf("failed to unmarshal product: %w", err)
+				}
+				products = append(products, &product)
+			}
+			pending = result.UnprocessedKeys
+		}
+	}
+	return products, nil
+}
+
 func (r *productRepo) Update(ctx context.Context, productId, category string, updates map[string]interface{}) (*domain.Product, error) {
 
 	var updateParts []string
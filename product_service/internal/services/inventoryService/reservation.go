@@ -0,0 +1,284 @@
+package inventoryservice
+
+import (
+	"context"
+	"errors"
+	"log"
+	"product_service/internal/domain"
+	inventoryrepo "product_service/internal/repo/inventoryRepo"
+	"product_service/internal/utils"
+	productpb "product_service/proto/gen"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultReservationTTL = 15 * time.Minute
+
+// ReserveStock holds stock for the given items until the reservation is
+// released or expires. Reserving the same id twice returns the existing
+// reservation. These reservations are keyed apart from order reservations,
+// so their ids can never stand in for an order.
+func (s *service) ReserveStock(ctx context.Context, req *productpb.ReserveStockRequest) (*productpb.Reservation, error) {
+	ttl := defaultReservationTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+
+	now := time.Now().UTC()
+	key := utils.ManualReservationKey(req.ReservationId)
+	reservation := &domain.Reservation{
+		OrderID:   key,
+		Items:     mergeReservationItems(req.Items),
+		Status:    domain.ReservationStatusReserved,
+		ExpiresAt: now.Add(ttl).Unix(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := s.inventoryRepo.Reserve(ctx, reservation)
+	var stockErr *inventoryrepo.StockError
+	switch {
+	case err == nil:
+		return utils.ReservationToProto(reservation), nil
+	case errors.Is(err, inventoryrepo.ErrAlreadyReserved):
+		existing, err := s.inventoryRepo.GetReservation(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		return utils.ReservationToProto(existing), nil
+	case errors.As(err, &stockErr):
+		return nil, status.Errorf(codes.FailedPrecondition, "insufficient stock for products %v", stockErr.ProductIDs)
+	default:
+		return nil, err
+	}
+}
+
+// ReleaseStock releases a reservation made with ReserveStock.
+func (s *service) ReleaseStock(ctx context.Context, reservationID string) (*productpb.Reservation, error) {
+	reservation, err := s.releaseReservation(ctx, utils.ManualReservationKey(reservationID))
+	if err != nil {
+		return nil, err
+	}
+	return utils.ReservationToProto(reservation), nil
+}
+
+// releaseReservation returns a reservation's stock. Releasing a reservation
+// that was already released or expired is a no-op that returns it as is.
+func (s *service) releaseReservation(ctx context.Context, orderID string) (*domain.Reservation, error) {
+	reservation, err := s.inventoryRepo.GetReservation(ctx, orderID)
+	if errors.Is(err, inventoryrepo.ErrReservationNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if reservation.Status != domain.ReservationStatusReserved {
+		return reservation, nil
+	}
+
+	err = s.inventoryRepo.Release(ctx, reservation, domain.ReservationStatusReleased)
+	if errors.Is(err, inventoryrepo.ErrReservationNotActive) {
+		return s.inventoryRepo.GetReservation(ctx, orderID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	reservation.Status = domain.ReservationStatusReleased
+	reservation.UpdatedAt = time.Now().UTC()
+	return reservation, nil
+}
+
+// HandleOrderCancelled releases the stock reserved for a cancelled order.
+// An order that has no reservation yet, because the cancel overtook its
+// OrderCreated event, gets a cancelled tombstone instead so it can never
+// reserve stock later. Redelivered events are no-ops.
+func (s *service) HandleOrderCancelled(ctx context.Context, event *productpb.OrderCancelledEvent) error {
+	reservation, err := s.releaseReservation(ctx, event.OrderId)
+	if status.Code(err) == codes.NotFound {
+		now := time.Now().UTC()
+		err = s.inventoryRepo.Tombstone(ctx, &domain.Reservation{
+			OrderID:   event.OrderId,
+			Status:    domain.ReservationStatusCancelled,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if errors.Is(err, inventoryrepo.ErrAlreadyReserved) {
+			// The order was reserved since the lookup; release it.
+			reservation, err = s.releaseReservation(ctx, event.OrderId)
+		} else if err == nil {
+			log.Printf("order %s cancelled before it was reserved", event.OrderId)
+			return nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("order %s cancelled, reservation %s", event.OrderId, reservation.Status)
+	return nil
+}
+
+// HandleOrderRefunded puts the refunded units of an order back on sale by
+// returning them from its reservation to stock. Orders whose stock is no
+// longer held, and redelivered events, are no-ops.
+func (s *service) HandleOrderRefunded(ctx context.Context, event *productpb.OrderRefundedEvent) error {
+	reservation, err := s.inventoryRepo.GetReservation(ctx, event.OrderId)
+	if errors.Is(err, inventoryrepo.ErrReservationNotFound) {
+		log.Printf("order %s refunded without a reservation", event.OrderId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if reservation.Status != domain.ReservationStatusReserved || slices.Contains(reservation.ReturnedRefunds, event.RefundId) {
+		log.Printf("refund %s of order %s has no stock to return", event.RefundId, event.OrderId)
+		return nil
+	}
+
+	items := make([]*productpb.ReservationItem, 0, len(event.Items))
+	for _, item := range event.Items {
+		if item.Quantity > 0 {
+			items = append(items, &productpb.ReservationItem{
+				ProductId: item.ProductId,
+				Category:  item.Category,
+				Quantity:  item.Quantity,
+			})
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	err = s.inventoryRepo.ReturnStock(ctx, reservation, event.RefundId, mergeReservationItems(items))
+	if errors.Is(err, inventoryrepo.ErrReservationNotActive) {
+		log.Printf("refund %s of order %s already returned", event.RefundId, event.OrderId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("refund %s of order %s returned to stock", event.RefundId, event.OrderId)
+	return nil
+}
+
+// HandleOrderPaid keeps the stock reserved for a paid order until it ships.
+// If the reservation expired before the payment arrived, the stock is
+// reserved again; when some of it was sold in the meantime the order is
+// logged as oversold, since a captured payment cannot be turned down here.
+func (s *service) HandleOrderPaid(ctx context.Context, event *productpb.OrderPaidEvent) error {
+	reservation, err := s.inventoryRepo.GetReservation(ctx, event.OrderId)
+	if errors.Is(err, inventoryrepo.ErrReservationNotFound) {
+		log.Printf("order %s paid without a reservation", event.OrderId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case reservation.Status == domain.ReservationStatusReserved && reservation.ExpiresAt == 0:
+		return nil
+	case reservation.Status != domain.ReservationStatusReserved && reservation.Status != domain.ReservationStatusExpired:
+		log.Printf("order %s paid with a %s reservation", event.OrderId, reservation.Status)
+		return nil
+	}
+
+	// ErrReservationNotActive means the reservation expired or was kept
+	// since it was read; the retried event sees its new status.
+	err = s.inventoryRepo.Keep(ctx, reservation)
+	var stockErr *inventoryrepo.StockError
+	if errors.As(err, &stockErr) {
+		log.Printf("order %s was paid after its reservation expired and is oversold: %v", event.OrderId, stockErr)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("order %s paid, reservation kept", event.OrderId)
+	return nil
+}
+
+// HandleOrderShipped takes the units reserved for a shipped order off hand.
+// Redelivered events are no-ops.
+func (s *service) HandleOrderShipped(ctx context.Context, event *productpb.OrderShippedEvent) error {
+	reservation, err := s.inventoryRepo.GetReservation(ctx, event.OrderId)
+	if errors.Is(err, inventoryrepo.ErrReservationNotFound) {
+		log.Printf("order %s shipped without a reservation", event.OrderId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if reservation.Status != domain.ReservationStatusReserved {
+		log.Printf("order %s shipped with a %s reservation", event.OrderId, reservation.Status)
+		return nil
+	}
+
+	err = s.inventoryRepo.Fulfil(ctx, reservation)
+	if errors.Is(err, inventoryrepo.ErrReservationNotActive) {
+		log.Printf("reservation of order %s is no longer active", event.OrderId)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("order %s shipped, reservation fulfilled", event.OrderId)
+	return nil
+}
+
+// ExpireReservations returns the stock of every reservation past its expiry,
+// including those of orders that were never paid.
+func (s *service) ExpireReservations(ctx context.Context, now time.Time) error {
+	expired, err := s.inventoryRepo.ListExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, reservation := range expired {
+		err := s.inventoryRepo.Release(ctx, reservation, domain.ReservationStatusExpired)
+		if err != nil && !errors.Is(err, inventoryrepo.ErrReservationNotActive) {
+			return err
+		}
+		if err == nil {
+			log.Printf("reservation %s expired, stock released", reservation.OrderID)
+		}
+	}
+	return nil
+}
+
+// RunExpirySweeper expires reservations every interval until ctx is
+// cancelled.
+func (s *service) RunExpirySweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ExpireReservations(ctx, time.Now()); err != nil && ctx.Err() == nil {
+				log.Printf("failed to expire reservations: %v", err)
+			}
+		}
+	}
+}
+
+// mergeReservationItems folds repeated products into one line, since a
+// DynamoDB transaction may touch each item only once.
+func mergeReservationItems(items []*productpb.ReservationItem) []domain.ReservationItem {
+	merged := make([]domain.ReservationItem, 0, len(items))
+	index := make(map[string]int, len(items))
+	for _, item := range items {
+		key := item.Category + "/" + item.ProductId
+		if i, ok := index[key]; ok {
+			merged[i].Quantity += int(item.Quantity)
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, domain.ReservationItem{
+			ProductID: item.ProductId,
+			Category:  item.Category,
+			Quantity:  int(item.Quantity),
+		})
+	}
+	return merged
+}
@@ -0,0 +1,159 @@
+package inventoryservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"product_service/internal/domain"
+	"product_service/internal/kafka"
+	inventoryrepo "product_service/internal/repo/inventoryRepo"
+	productrepo "product_service/internal/repo/productRepo"
+	productpb "product_service/proto/gen"
+	"strings"
+	"time"
+)
+
+type service struct {
+	productRepo         productrepo.ProductRepo
+	inventoryRepo       inventoryrepo.InventoryRepo
+	producer            kafka.Producer
+	orderReservationTTL time.Duration
+}
+
+type Service interface {
+	ValidateOrder(ctx context.Context, event *productpb.OrderCreatedEvent) (*productpb.OrderValidationResultEvent, error)
+	HandleOrderCreated(ctx context.Context, event *productpb.OrderCreatedEvent) error
+	HandleOrderCancelled(ctx context.Context, event *productpb.OrderCancelledEvent) error
+	HandleOrderRefunded(ctx context.Context, event *productpb.OrderRefundedEvent) error
+	HandleOrderPaid(ctx context.Context, event *productpb.OrderPaidEvent) error
+	HandleOrderShipped(ctx context.Context, event *productpb.OrderShippedEvent) error
+	AdjustStock(ctx context.Context, email string, req *productpb.AdjustStockRequest) (*productpb.AdjustStockResponse, error)
+	ListStockAdjustments(ctx context.Context, email string, req *productpb.ListStockAdjustmentsRequest) (*productpb.ListStockAdjustmentsResponse, error)
+	ReserveStock(ctx context.Context, req *productpb.ReserveStockRequest) (*productpb.Reservation, error)
+	ReleaseStock(ctx context.Context, reservationID string) (*productpb.Reservation, error)
+	ExpireReservations(ctx context.Context, now time.Time) error
+	RunExpirySweeper(ctx context.Context, interval time.Duration)
+}
+
+// NewService returns the inventory service. Stock reserved for an order is
+// released after orderReservationTTL unless the order is paid by then.
+func NewService(productRepo productrepo.ProductRepo, inventoryRepo inventoryrepo.InventoryRepo, producer kafka.Producer, orderReservationTTL time.Duration) Service {
+	return &service{
+		productRepo:         productRepo,
+		inventoryRepo:       inventoryRepo,
+		producer:            producer,
+		orderReservationTTL: orderReservationTTL,
+	}
+}
+
+// HandleOrderCreated validates the order and publishes the verdict. Errors
+// are only returned for transient failures so the consumer retries them.
+func (s *service) HandleOrderCreated(ctx context.Context, event *productpb.OrderCreatedEvent) error {
+	result, err := s.ValidateOrder(ctx, event)
+	if err != nil {
+		return err
+	}
+	if err := s.producer.PublishValidationResult(ctx, result); err != nil {
+		return fmt.Errorf("failed to publish validation result: %w", err)
+	}
+	log.Printf("order %s validated: valid=%t", result.OrderId, result.IsValid)
+	return nil
+}
+
+// ValidateOrder checks every item against tracked stock and reserves the
+// whole order atomically until it is paid or the reservation expires.
+// Reserving an already reserved order is treated as success so redelivered
+// events produce the same verdict, unless the order was cancelled before it
+// could be reserved.
+func (s *service) ValidateOrder(ctx context.Context, event *productpb.OrderCreatedEvent) (*productpb.OrderValidationResultEvent, error) {
+	if len(event.Items) == 0 {
+		return invalidResult(event.OrderId, []*productpb.OrderItemError{{ErrorMessage: "order has no items"}}), nil
+	}
+	if len(event.Items) > inventoryrepo.MaxReservationItems {
+		msg := fmt.Sprintf("order has %d items, at most %d are supported", len(event.Items), inventoryrepo.MaxReservationItems)
+		return invalidResult(event.OrderId, []*productpb.OrderItemError{{ErrorMessage: msg}}), nil
+	}
+
+	var itemErrors []*productpb.OrderItemError
+	now := time.Now().UTC()
+	reservation := &domain.Reservation{
+		OrderID:   event.OrderId,
+		Status:    domain.ReservationStatusReserved,
+		ExpiresAt: now.Add(s.orderReservationTTL).Unix(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, item := range event.Items {
+		if item.Quantity <= 0 {
+			itemErrors = append(itemErrors, itemError(item.ProductId, "quantity must be greater than zero"))
+			continue
+		}
+		product, err := s.productRepo.GetById(ctx, item.ProductId, item.Category)
+		if errors.Is(err, productrepo.ErrProductNotFound) {
+			itemErrors = append(itemErrors, itemError(item.ProductId, "product not found"))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if product.Stock < int(item.Quantity) {
+			itemErrors = append(itemErrors, itemError(item.ProductId,
+				fmt.Sprintf("insufficient stock: requested %d, available %d", item.Quantity, product.Stock)))
+			continue
+		}
+		reservation.Items = append(reservation.Items, domain.ReservationItem{
+			ProductID: item.ProductId,
+			Category:  item.Category,
+			Quantity:  int(item.Quantity),
+		})
+	}
+	if len(itemErrors) > 0 {
+		return invalidResult(event.OrderId, itemErrors), nil
+	}
+
+	err := s.inventoryRepo.Reserve(ctx, reservation)
+	var stockErr *inventoryrepo.StockError
+	switch {
+	case err == nil:
+		return &productpb.OrderValidationResultEvent{OrderId: event.OrderId, IsValid: true}, nil
+	case errors.Is(err, inventoryrepo.ErrAlreadyReserved):
+		existing, err := s.inventoryRepo.GetReservation(ctx, event.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		if existing.Status == domain.ReservationStatusCancelled {
+			return invalidResult(event.OrderId, []*productpb.OrderItemError{{ErrorMessage: "order was cancelled"}}), nil
+		}
+		return &productpb.OrderValidationResultEvent{OrderId: event.OrderId, IsValid: true}, nil
+	case errors.As(err, &stockErr):
+		// Stock changed between the read and the conditional write.
+		for _, productID := range stockErr.ProductIDs {
+			itemErrors = append(itemErrors, itemError(productID, "insufficient stock"))
+		}
+		return invalidResult(event.OrderId, itemErrors), nil
+	default:
+		return nil, err
+	}
+}
+
+func itemError(productID, msg string) *productpb.OrderItemError {
+	return &productpb.OrderItemError{ProductId: productID, ErrorMessage: msg}
+}
+
+func invalidResult(orderID string, itemErrors []*productpb.OrderItemError) *productpb.OrderValidationResultEvent {
+	messages := make([]string, 0, len(itemErrors))
+	for _, e := range itemErrors {
+		if e.ProductId == "" {
+			messages = append(messages, e.ErrorMessage)
+			continue
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s", e.ProductId, e.ErrorMessage))
+	}
+	return &productpb.OrderValidationResultEvent{
+		OrderId:      orderID,
+		IsValid:      false,
+		ErrorMessage: strings.Join(messages, "; "),
+		ItemErrors:   itemErrors,
+	}
+}
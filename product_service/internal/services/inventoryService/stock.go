@@ -0,0 +1,122 @@
+package inventoryservice
+
+import (
+	"context"
+	"errors"
+	"product_service/internal/domain"
+	inventoryrepo "product_service/internal/repo/inventoryRepo"
+	productrepo "product_service/internal/repo/productRepo"
+	"product_service/internal/utils"
+	productpb "product_service/proto/gen"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	adjustStockAttempts     = 3
+	defaultAdjustmentsLimit = 20
+)
+
+// AdjustStock applies a restock or a counted correction to a product's
+// on-hand stock and records who did it. Only the product's creator may
+// adjust its stock.
+func (s *service) AdjustStock(ctx context.Context, email string, req *productpb.AdjustStockRequest) (*productpb.AdjustStockResponse, error) {
+	if req.Type == domain.StockAdjustmentRestock && req.Quantity == 0 {
+		return nil, status.Error(codes.InvalidArgument, "restock quantity must be greater than zero")
+	}
+	if req.Type == domain.StockAdjustmentCorrection && req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required for stock corrections")
+	}
+
+	// Stock also moves through reservations, so retry when the product
+	// changes between the read and the conditional write.
+	for attempt := 1; ; attempt++ {
+		product, err := s.getOwnedProduct(ctx, email, req.ProductId, req.Category)
+		if err != nil {
+			return nil, err
+		}
+
+		newOnHand := int(req.Quantity)
+		if req.Type == domain.StockAdjustmentRestock {
+			newOnHand = product.OnHand() + int(req.Quantity)
+		}
+		if newOnHand < product.Reserved {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"on-hand stock cannot be set below the %d reserved units", product.Reserved)
+		}
+
+		adjustmentID, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		adjustment := &domain.StockAdjustment{
+			ProductID:      product.ProductID,
+			AdjustmentID:   adjustmentID.String(),
+			Category:       product.Category,
+			Type:           req.Type,
+			PreviousOnHand: product.OnHand(),
+			NewOnHand:      newOnHand,
+			Delta:          newOnHand - product.OnHand(),
+			Actor:          email,
+			Reason:         req.Reason,
+			CreatedAt:      time.Now().UTC(),
+		}
+
+		err = s.inventoryRepo.AdjustStock(ctx, product, adjustment)
+		if errors.Is(err, inventoryrepo.ErrStockChanged) && attempt < adjustStockAttempts {
+			continue
+		}
+		if errors.Is(err, inventoryrepo.ErrStockChanged) {
+			return nil, status.Error(codes.Aborted, "stock changed while adjusting, please retry")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		product.Stock = newOnHand - product.Reserved
+		product.UpdatedAt = adjustment.CreatedAt
+		return &productpb.AdjustStockResponse{
+			Product:    utils.ProductToProto(product),
+			Adjustment: utils.StockAdjustmentToProto(adjustment),
+		}, nil
+	}
+}
+
+func (s *service) ListStockAdjustments(ctx context.Context, email string, req *productpb.ListStockAdjustmentsRequest) (*productpb.ListStockAdjustmentsResponse, error) {
+	product, err := s.getOwnedProduct(ctx, email, req.ProductId, req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultAdjustmentsLimit
+	}
+	adjustments, err := s.inventoryRepo.ListAdjustments(ctx, product.ProductID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := make([]*productpb.StockAdjustment, 0, len(adjustments))
+	for _, a := range adjustments {
+		pb = append(pb, utils.StockAdjustmentToProto(a))
+	}
+	return &productpb.ListStockAdjustmentsResponse{Adjustments: pb}, nil
+}
+
+func (s *service) getOwnedProduct(ctx context.Context, email, productID, category string) (*domain.Product, error) {
+	product, err := s.productRepo.GetById(ctx, productID, category)
+	if errors.Is(err, productrepo.ErrProductNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if product.CreatedBy != email {
+		return nil, status.Error(codes.PermissionDenied, "only the product's creator can manage its stock")
+	}
+	return product, nil
+}
@@ -22,6 +22,7 @@ type Service interface {
 	Create(ctx context.Context, payload *productpb.CreateProductRequest, email string) (*productpb.CreateProductResponse, error)
 	GetAll(ctx context.Context, req *productpb.GetProductsRequest) (*productpb.GetProductsResponse, error)
 	GetById(ctx context.Context, req *productpb.GetProductByIdRequest) (*productpb.GetProductByIdResponse, error)
+	BatchGet(ctx context.Context, req *productpb.BatchGetProductsRequest) (*productpb.BatchGetProductsResponse, error)
 	Update(ctx context.Context, req *productpb.UpdateProductRequest, email string) (*productpb.UpdateProductResponse, error)
 	Delete(ctx context.Context, req *productpb.DeleteProductRequest) (*productpb.DeleteProductResponse, error)
 }
@@ -56,6 +57,7 @@ func (s *service) Create(ctx context.Context, payload *productpb.CreateProductRe
 		Status:      payload.Status,
 		IsFeatured:  payload.IsFeatured,
 		Tags:        payload.Tags,
+		Stock:       int(payload.Stock),
 		CreatedAt:   time.Now().UTC(),
 		UpdatedAt:   time.Now().UTC(),
 	}
@@ -78,18 +80,7 @@ func (s *service) GetAll(ctx context.Context, req *productpb.GetProductsRequest)
 	}
 	pbProducts := make([]*productpb.Product, 0, len(products))
 	for _, p := range products {
-		pbProducts = append(pbProducts, &productpb.Product{
-			ProductId:   p.ProductID,
-			Name:        p.Name,
-			Description: p.Description,
-			Category:    p.Category,
-			Price:       p.Price,
-			ImageUrls:   p.ImageURLs,
-			Status:      p.Status,
-			IsFeatured:  p.IsFeatured,
-			Tags:        p.Tags,
-			CreatedBy:   p.CreatedBy,
-		})
+		pbProducts = append(pbProducts, utils.ProductToProto(p))
 	}
 	return &productpb.GetProductsResponse{
 		Products:   pbProducts,
@@ -108,23 +99,27 @@ func (s *service) GetById(ctx context.Context, req *productpb.GetProductByIdRequ
 		return nil, err
 
 	}
-	pbProduct := &productpb.Product{
-		ProductId:   product.ProductID,
-		Name:        product.Name,
-		Description: product.Description,
-		Category:    product.Category,
-		Price:       product.Price,
-		ImageUrls:   product.ImageURLs,
-		Status:      product.Status,
-		IsFeatured:  product.IsFeatured,
-		Tags:        product.Tags,
-		CreatedBy:   product.CreatedBy,
-	}
 	return &productpb.GetProductByIdResponse{
-		Product: pbProduct,
+		Product: utils.ProductToProto(product),
 	}, nil
 }
 
+func (s *service) BatchGet(ctx context.Context, req *productpb.BatchGetProductsRequest) (*productpb.BatchGetProductsResponse, error) {
+	keys := make([]productrepo.ProductKey, 0, len(req.Products))
+	for _, key := range req.Products {
+		keys = append(keys, productrepo.ProductKey{ProductID: key.ProductId, Category: key.Category})
+	}
+	products, err := s.repo.GetByIds(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	pbProducts := make([]*productpb.Product, 0, len(products))
+	for _, p := range products {
+		pbProducts = append(pbProducts, utils.ProductToProto(p))
+	}
+	return &productpb.BatchGetProductsResponse{Products: pbProducts}, nil
+}
+
 func (s *service) Update(ctx context.Context, req *productpb.UpdateProductRequest, email string) (*productpb.UpdateProductResponse, error) {
 
 	if email == "" {
@@ -204,17 +199,6 @@ func (s *service) Delete(ctx context.Context, req *productpb.DeleteProductReques
 		return nil, err
 
 	}
-	pb := &productpb.Product{
-		ProductId:   product.ProductID,
-		Name:        product.Name,
-		Description: product.Description,
-		Category:    product.Category,
-		Price:       product.Price,
-		ImageUrls:   product.ImageURLs,
-		Status:      product.Status,
-		IsFeatured:  product.IsFeatured,
-		Tags:        product.Tags,
-	}
-	return &productpb.DeleteProductResponse{Product: pb}, nil
+	return &productpb.DeleteProductResponse{Product: utils.ProductToProto(product)}, nil
 
 }
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"product_service/internal/domain"
+	productpb "product_service/proto/gen"
+	"time"
+)
+
+func StockAdjustmentToProto(a *domain.StockAdjustment) *productpb.StockAdjustment {
+	return &productpb.StockAdjustment{
+		AdjustmentId:   a.AdjustmentID,
+		ProductId:      a.ProductID,
+		Category:       a.Category,
+		Type:           a.Type,
+		PreviousOnHand: int32(a.PreviousOnHand),
+		NewOnHand:      int32(a.NewOnHand),
+		Delta:          int32(a.Delta),
+		Actor:          a.Actor,
+		Reason:         a.Reason,
+		CreatedAt:      a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ReservationToProto(r *domain.Reservation) *productpb.Reservation {
+	items := make([]*productpb.ReservationItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, &productpb.ReservationItem{
+			ProductId: item.ProductID,
+			Category:  item.Category,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	pb := &productpb.Reservation{
+		ReservationId: ReservationID(r.OrderID),
+		Items:         items,
+		Status:        r.Status,
+		CreatedAt:     r.CreatedAt.Format(time.RFC3339),
+	}
+	if r.ExpiresAt > 0 {
+		pb.ExpiresAt = time.Unix(r.ExpiresAt, 0).UTC().Format(time.RFC3339)
+	}
+	return pb
+}
@@ -1,6 +1,9 @@
 package utils
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func GenerateProductPK(category string) string {
 	return fmt.Sprintf("CATEGORY#%s", category)
@@ -9,3 +12,16 @@ func GenerateProductPK(category string) string {
 func GenerateProductSK(productID string) string {
 	return fmt.Sprintf("PRODUCT#%s", productID)
 }
+
+// manualReservationPrefix keeps reservations made through ReserveStock apart
+// from the reservations of orders, which are keyed by the bare order id.
+const manualReservationPrefix = "MANUAL#"
+
+func ManualReservationKey(reservationID string) string {
+	return manualReservationPrefix + reservationID
+}
+
+// ReservationID returns the id a reservation key was created from.
+func ReservationID(key string) string {
+	return strings.TrimPrefix(key, manualReservationPrefix)
+}
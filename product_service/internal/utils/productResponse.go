@@ -16,6 +16,27 @@ func ProductResponse(productData *domain.Product) *productpb.CreateProductRespon
 		Status:      productData.Status,
 		IsFeatured:  productData.IsFeatured,
 		Tags:        productData.Tags,
+		StockOnHand: int32(productData.OnHand()),
+		Reserved:    int32(productData.Reserved),
+		Available:   int32(productData.Stock),
 	}
 
 }
+
+func ProductToProto(p *domain.Product) *productpb.Product {
+	return &productpb.Product{
+		ProductId:   p.ProductID,
+		Name:        p.Name,
+		Description: p.Description,
+		Category:    p.Category,
+		Price:       p.Price,
+		ImageUrls:   p.ImageURLs,
+		Status:      p.Status,
+		IsFeatured:  p.IsFeatured,
+		Tags:        p.Tags,
+		CreatedBy:   p.CreatedBy,
+		StockOnHand: int32(p.OnHand()),
+		Reserved:    int32(p.Reserved),
+		Available:   int32(p.Stock),
+	}
+}
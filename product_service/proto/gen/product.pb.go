@@ -33,6 +33,7 @@ type CreateProductRequest struct {
 	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	IsFeatured    bool                   `protobuf:"varint,7,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
 	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Stock         int32                  `protobuf:"varint,9,opt,name=stock,proto3" json:"stock,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,6 +124,13 @@ func (x *CreateProductRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -134,6 +142,9 @@ type CreateProductResponse struct {
 	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 	IsFeatured    bool                   `protobuf:"varint,8,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
 	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	StockOnHand   int32                  `protobuf:"varint,10,opt,name=stock_on_hand,json=stockOnHand,proto3" json:"stock_on_hand,omitempty"`
+	Reserved      int32                  `protobuf:"varint,11,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Available     int32                  `protobuf:"varint,12,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -231,6 +242,27 @@ func (x *CreateProductResponse) GetTags() []string {
 	return nil
 }
 
+func (x *CreateProductResponse) GetStockOnHand() int32 {
+	if x != nil {
+		return x.StockOnHand
+	}
+	return 0
+}
+
+func (x *CreateProductResponse) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *CreateProductResponse) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
 type Product struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -243,6 +275,9 @@ type Product struct {
 	IsFeatured    bool                   `protobuf:"varint,8,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
 	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	StockOnHand   int32                  `protobuf:"varint,11,opt,name=stock_on_hand,json=stockOnHand,proto3" json:"stock_on_hand,omitempty"`
+	Reserved      int32                  `protobuf:"varint,12,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Available     int32                  `protobuf:"varint,13,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -347,6 +382,27 @@ func (x *Product) GetCreatedBy() string {
 	return ""
 }
 
+func (x *Product) GetStockOnHand() int32 {
+	if x != nil {
+		return x.StockOnHand
+	}
+	return 0
+}
+
+func (x *Product) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *Product) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
 type GetProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -547,6 +603,147 @@ func (x *GetProductByIdResponse) GetProduct() *Product {
 	return nil
 }
 
+type ProductKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductKey) Reset() {
+	*x = ProductKey{}
+	mi := &file_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductKey) ProtoMessage() {}
+
+func (x *ProductKey) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductKey.ProtoReflect.Descriptor instead.
+func (*ProductKey) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProductKey) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductKey) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type BatchGetProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductKey          `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsRequest) Reset() {
+	*x = BatchGetProductsRequest{}
+	mi := &file_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsRequest) ProtoMessage() {}
+
+func (x *BatchGetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetProductsRequest) GetProducts() []*ProductKey {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// Products that do not exist are left out of the response.
+type BatchGetProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsResponse) Reset() {
+	*x = BatchGetProductsResponse{}
+	mi := &file_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsResponse) ProtoMessage() {}
+
+func (x *BatchGetProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchGetProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
 type UpdateProductRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	Category  string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -566,7 +763,7 @@ type UpdateProductRequest struct {
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +775,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +788,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{7}
+	return file_product_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateProductRequest) GetCategory() string {
@@ -682,7 +879,7 @@ type UpdateProductResponse struct {
 
 func (x *UpdateProductResponse) Reset() {
 	*x = UpdateProductResponse{}
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +891,7 @@ func (x *UpdateProductResponse) String() string {
 func (*UpdateProductResponse) ProtoMessage() {}
 
 func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +904,7 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{8}
+	return file_product_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateProductResponse) GetProductId() string {
@@ -790,7 +987,7 @@ type DeleteProductRequest struct {
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +999,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +1012,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{9}
+	return file_product_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteProductRequest) GetProductId() string {
@@ -841,7 +1038,7 @@ type DeleteProductResponse struct {
 
 func (x *DeleteProductResponse) Reset() {
 	*x = DeleteProductResponse{}
-	mi := &file_product_proto_msgTypes[10]
+	mi := &file_product_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -853,7 +1050,7 @@ func (x *DeleteProductResponse) String() string {
 func (*DeleteProductResponse) ProtoMessage() {}
 
 func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[10]
+	mi := &file_product_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,7 +1063,7 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{10}
+	return file_product_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteProductResponse) GetProduct() *Product {
@@ -876,38 +1073,33 @@ func (x *DeleteProductResponse) GetProduct() *Product {
 	return nil
 }
 
-type StandardResponse struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	Success    bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message    string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	StatusCode int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	// Types that are valid to be assigned to Result:
-	//
-	//	*StandardResponse_ProductData
-	//	*StandardResponse_Products
-	//	*StandardResponse_Product
-	//	*StandardResponse_UpdatedProduct
-	//	*StandardResponse_DeletedProduct
-	Result        isStandardResponse_Result `protobuf_oneof:"result"`
+type AdjustStockRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Category  string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// restock adds quantity units; correction sets the counted on-hand units.
+	Type          string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Quantity      int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StandardResponse) Reset() {
-	*x = StandardResponse{}
-	mi := &file_product_proto_msgTypes[11]
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_product_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StandardResponse) String() string {
+func (x *AdjustStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StandardResponse) ProtoMessage() {}
+func (*AdjustStockRequest) ProtoMessage() {}
 
-func (x *StandardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[11]
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,86 +1110,721 @@ func (x *StandardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StandardResponse.ProtoReflect.Descriptor instead.
-func (*StandardResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *StandardResponse) GetSuccess() bool {
+func (x *AdjustStockRequest) GetCategory() string {
 	if x != nil {
-		return x.Success
+		return x.Category
 	}
-	return false
+	return ""
 }
 
-func (x *StandardResponse) GetMessage() string {
+func (x *AdjustStockRequest) GetProductId() string {
 	if x != nil {
-		return x.Message
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *StandardResponse) GetStatusCode() int32 {
+func (x *AdjustStockRequest) GetType() string {
 	if x != nil {
-		return x.StatusCode
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *StandardResponse) GetResult() isStandardResponse_Result {
+func (x *AdjustStockRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.Result
+		return x.Quantity
 	}
-	return nil
+	return 0
 }
 
-func (x *StandardResponse) GetProductData() *CreateProductResponse {
+func (x *AdjustStockRequest) GetReason() string {
 	if x != nil {
-		if x, ok := x.Result.(*StandardResponse_ProductData); ok {
-			return x.ProductData
-		}
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *StandardResponse) GetProducts() *GetProductsResponse {
+type StockAdjustment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AdjustmentId   string                 `protobuf:"bytes,1,opt,name=adjustment_id,json=adjustmentId,proto3" json:"adjustment_id,omitempty"`
+	ProductId      string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Category       string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	PreviousOnHand int32                  `protobuf:"varint,5,opt,name=previous_on_hand,json=previousOnHand,proto3" json:"previous_on_hand,omitempty"`
+	NewOnHand      int32                  `protobuf:"varint,6,opt,name=new_on_hand,json=newOnHand,proto3" json:"new_on_hand,omitempty"`
+	Delta          int32                  `protobuf:"varint,7,opt,name=delta,proto3" json:"delta,omitempty"`
+	Actor          string                 `protobuf:"bytes,8,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason         string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockAdjustment) Reset() {
+	*x = StockAdjustment{}
+	mi := &file_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAdjustment) ProtoMessage() {}
+
+func (x *StockAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[15]
 	if x != nil {
-		if x, ok := x.Result.(*StandardResponse_Products); ok {
-			return x.Products
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StandardResponse) GetProduct() *GetProductByIdResponse {
+// Deprecated: Use StockAdjustment.ProtoReflect.Descriptor instead.
+func (*StockAdjustment) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StockAdjustment) GetAdjustmentId() string {
 	if x != nil {
-		if x, ok := x.Result.(*StandardResponse_Product); ok {
-			return x.Product
-		}
+		return x.AdjustmentId
 	}
-	return nil
+	return ""
 }
 
-func (x *StandardResponse) GetUpdatedProduct() *UpdateProductResponse {
+func (x *StockAdjustment) GetProductId() string {
 	if x != nil {
-		if x, ok := x.Result.(*StandardResponse_UpdatedProduct); ok {
-			return x.UpdatedProduct
-		}
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *StandardResponse) GetDeletedProduct() *DeleteProductResponse {
+func (x *StockAdjustment) GetCategory() string {
 	if x != nil {
-		if x, ok := x.Result.(*StandardResponse_DeletedProduct); ok {
-			return x.DeletedProduct
-		}
+		return x.Category
 	}
-	return nil
+	return ""
 }
 
-type isStandardResponse_Result interface {
-	isStandardResponse_Result()
+func (x *StockAdjustment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StockAdjustment) GetPreviousOnHand() int32 {
+	if x != nil {
+		return x.PreviousOnHand
+	}
+	return 0
+}
+
+func (x *StockAdjustment) GetNewOnHand() int32 {
+	if x != nil {
+		return x.NewOnHand
+	}
+	return 0
+}
+
+func (x *StockAdjustment) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockAdjustment) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockAdjustment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdjustStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Adjustment    *StockAdjustment       `protobuf:"bytes,2,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AdjustStockResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *AdjustStockResponse) GetAdjustment() *StockAdjustment {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+type ListStockAdjustmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockAdjustmentsRequest) Reset() {
+	*x = ListStockAdjustmentsRequest{}
+	mi := &file_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockAdjustmentsRequest) ProtoMessage() {}
+
+func (x *ListStockAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListStockAdjustmentsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListStockAdjustmentsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListStockAdjustmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListStockAdjustmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adjustments   []*StockAdjustment     `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockAdjustmentsResponse) Reset() {
+	*x = ListStockAdjustmentsResponse{}
+	mi := &file_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockAdjustmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockAdjustmentsResponse) ProtoMessage() {}
+
+func (x *ListStockAdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockAdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListStockAdjustmentsResponse) GetAdjustments() []*StockAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type ReservationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReservationItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// 0 uses the default expiry.
+	TtlSeconds    int32 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReserveStockRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReleaseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockRequest) ProtoMessage() {}
+
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReleaseStockRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Reservation) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *Reservation) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Reservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reservation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Reservation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type StandardResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Success    bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	StatusCode int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*StandardResponse_ProductData
+	//	*StandardResponse_Products
+	//	*StandardResponse_Product
+	//	*StandardResponse_UpdatedProduct
+	//	*StandardResponse_DeletedProduct
+	//	*StandardResponse_StockAdjustment
+	//	*StandardResponse_StockAdjustments
+	//	*StandardResponse_Reservation
+	//	*StandardResponse_BatchProducts
+	Result        isStandardResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StandardResponse) Reset() {
+	*x = StandardResponse{}
+	mi := &file_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StandardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardResponse) ProtoMessage() {}
+
+func (x *StandardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardResponse.ProtoReflect.Descriptor instead.
+func (*StandardResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StandardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StandardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *StandardResponse) GetResult() isStandardResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetProductData() *CreateProductResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_ProductData); ok {
+			return x.ProductData
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetProducts() *GetProductsResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_Products); ok {
+			return x.Products
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetProduct() *GetProductByIdResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_Product); ok {
+			return x.Product
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetUpdatedProduct() *UpdateProductResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_UpdatedProduct); ok {
+			return x.UpdatedProduct
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetDeletedProduct() *DeleteProductResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_DeletedProduct); ok {
+			return x.DeletedProduct
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetStockAdjustment() *AdjustStockResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_StockAdjustment); ok {
+			return x.StockAdjustment
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetStockAdjustments() *ListStockAdjustmentsResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_StockAdjustments); ok {
+			return x.StockAdjustments
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetReservation() *Reservation {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_Reservation); ok {
+			return x.Reservation
+		}
+	}
+	return nil
+}
+
+func (x *StandardResponse) GetBatchProducts() *BatchGetProductsResponse {
+	if x != nil {
+		if x, ok := x.Result.(*StandardResponse_BatchProducts); ok {
+			return x.BatchProducts
+		}
+	}
+	return nil
+}
+
+type isStandardResponse_Result interface {
+	isStandardResponse_Result()
 }
 
 type StandardResponse_ProductData struct {
@@ -1020,6 +1847,22 @@ type StandardResponse_DeletedProduct struct {
 	DeletedProduct *DeleteProductResponse `protobuf:"bytes,8,opt,name=deleted_product,json=deletedProduct,proto3,oneof"`
 }
 
+type StandardResponse_StockAdjustment struct {
+	StockAdjustment *AdjustStockResponse `protobuf:"bytes,9,opt,name=stock_adjustment,json=stockAdjustment,proto3,oneof"`
+}
+
+type StandardResponse_StockAdjustments struct {
+	StockAdjustments *ListStockAdjustmentsResponse `protobuf:"bytes,10,opt,name=stock_adjustments,json=stockAdjustments,proto3,oneof"`
+}
+
+type StandardResponse_Reservation struct {
+	Reservation *Reservation `protobuf:"bytes,11,opt,name=reservation,proto3,oneof"`
+}
+
+type StandardResponse_BatchProducts struct {
+	BatchProducts *BatchGetProductsResponse `protobuf:"bytes,12,opt,name=batch_products,json=batchProducts,proto3,oneof"`
+}
+
 func (*StandardResponse_ProductData) isStandardResponse_Result() {}
 
 func (*StandardResponse_Products) isStandardResponse_Result() {}
@@ -1030,11 +1873,19 @@ func (*StandardResponse_UpdatedProduct) isStandardResponse_Result() {}
 
 func (*StandardResponse_DeletedProduct) isStandardResponse_Result() {}
 
+func (*StandardResponse_StockAdjustment) isStandardResponse_Result() {}
+
+func (*StandardResponse_StockAdjustments) isStandardResponse_Result() {}
+
+func (*StandardResponse_Reservation) isStandardResponse_Result() {}
+
+func (*StandardResponse_BatchProducts) isStandardResponse_Result() {}
+
 var File_product_proto protoreflect.FileDescriptor
 
 const file_product_proto_rawDesc = "" +
 	"\n" +
-	"\rproduct.proto\x12\x0fproduct_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xb9\x02\n" +
+	"\rproduct.proto\x12\x0fproduct_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xd8\x02\n" +
 	"\x14CreateProductRequest\x12\x1d\n" +
 	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12,\n" +
 	"\vdescription\x18\x02 \x01(\tB\n" +
@@ -1047,7 +1898,8 @@ const file_product_proto_rawDesc = "" +
 	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
 	"\vis_featured\x18\a \x01(\bR\n" +
 	"isFeatured\x12 \n" +
-	"\x04tags\x18\b \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x18\x1eR\x04tags\"\x8a\x02\n" +
+	"\x04tags\x18\b \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x18\x1eR\x04tags\x12\x1d\n" +
+	"\x05stock\x18\t \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05stock\"\xe8\x02\n" +
 	"\x15CreateProductResponse\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
@@ -1060,7 +1912,11 @@ const file_product_proto_rawDesc = "" +
 	"\x06status\x18\a \x01(\tR\x06status\x12\x1f\n" +
 	"\vis_featured\x18\b \x01(\bR\n" +
 	"isFeatured\x12\x12\n" +
-	"\x04tags\x18\t \x03(\tR\x04tags\"\x9b\x02\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\"\n" +
+	"\rstock_on_hand\x18\n" +
+	" \x01(\x05R\vstockOnHand\x12\x1a\n" +
+	"\breserved\x18\v \x01(\x05R\breserved\x12\x1c\n" +
+	"\tavailable\x18\f \x01(\x05R\tavailable\"\xf9\x02\n" +
 	"\aProduct\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
@@ -1076,7 +1932,10 @@ const file_product_proto_rawDesc = "" +
 	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\n" +
-	" \x01(\tR\tcreatedBy\"H\n" +
+	" \x01(\tR\tcreatedBy\x12\"\n" +
+	"\rstock_on_hand\x18\v \x01(\x05R\vstockOnHand\x12\x1a\n" +
+	"\breserved\x18\f \x01(\x05R\breserved\x12\x1c\n" +
+	"\tavailable\x18\r \x01(\x05R\tavailable\"H\n" +
 	"\x12GetProductsRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
 	"\x06search\x18\x02 \x01(\tR\x06search\"l\n" +
@@ -1089,7 +1948,17 @@ const file_product_proto_rawDesc = "" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\"L\n" +
 	"\x16GetProductByIdResponse\x122\n" +
-	"\aproduct\x18\x01 \x01(\v2\x18.product_service.ProductR\aproduct\"\x85\x04\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.product_service.ProductR\aproduct\"Y\n" +
+	"\n" +
+	"ProductKey\x12#\n" +
+	"\bcategory\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcategory\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\"^\n" +
+	"\x17BatchGetProductsRequest\x12C\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1b.product_service.ProductKeyB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\bproducts\"P\n" +
+	"\x18BatchGetProductsResponse\x124\n" +
+	"\bproducts\x18\x01 \x03(\v2\x18.product_service.ProductR\bproducts\"\x85\x04\n" +
 	"\x14UpdateProductRequest\x12#\n" +
 	"\bcategory\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcategory\x12&\n" +
 	"\n" +
@@ -1132,7 +2001,62 @@ const file_product_proto_rawDesc = "" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\"K\n" +
 	"\x15DeleteProductResponse\x122\n" +
-	"\aproduct\x18\x01 \x01(\v2\x18.product_service.ProductR\aproduct\"\xec\x03\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.product_service.ProductR\aproduct\"\xd8\x01\n" +
+	"\x12AdjustStockRequest\x12#\n" +
+	"\bcategory\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcategory\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\x12.\n" +
+	"\x04type\x18\x03 \x01(\tB\x1a\xfaB\x17r\x15R\arestockR\n" +
+	"correctionR\x04type\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bquantity\x12 \n" +
+	"\x06reason\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x06reason\"\xb2\x02\n" +
+	"\x0fStockAdjustment\x12#\n" +
+	"\radjustment_id\x18\x01 \x01(\tR\fadjustmentId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12(\n" +
+	"\x10previous_on_hand\x18\x05 \x01(\x05R\x0epreviousOnHand\x12\x1e\n" +
+	"\vnew_on_hand\x18\x06 \x01(\x05R\tnewOnHand\x12\x14\n" +
+	"\x05delta\x18\a \x01(\x05R\x05delta\x12\x14\n" +
+	"\x05actor\x18\b \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"\x8b\x01\n" +
+	"\x13AdjustStockResponse\x122\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.product_service.ProductR\aproduct\x12@\n" +
+	"\n" +
+	"adjustment\x18\x02 \x01(\v2 .product_service.StockAdjustmentR\n" +
+	"adjustment\"\x8b\x01\n" +
+	"\x1bListStockAdjustmentsRequest\x12#\n" +
+	"\bcategory\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcategory\x12&\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05limit\"b\n" +
+	"\x1cListStockAdjustmentsResponse\x12B\n" +
+	"\vadjustments\x18\x01 \x03(\v2 .product_service.StockAdjustmentR\vadjustments\"\x83\x01\n" +
+	"\x0fReservationItem\x12&\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tproductId\x12#\n" +
+	"\bcategory\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bcategory\x12#\n" +
+	"\bquantity\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\"\xb9\x01\n" +
+	"\x13ReserveStockRequest\x120\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\rreservationId\x12B\n" +
+	"\x05items\x18\x02 \x03(\v2 .product_service.ReservationItemB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10cR\x05items\x12,\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05B\v\xfaB\b\x1a\x06\x18\x80\xa3\x05(\x00R\n" +
+	"ttlSeconds\"E\n" +
+	"\x13ReleaseStockRequest\x12.\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\rreservationId\"\xc2\x01\n" +
+	"\vReservation\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x126\n" +
+	"\x05items\x18\x02 \x03(\v2 .product_service.ReservationItemR\x05items\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xb3\x06\n" +
 	"\x10StandardResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
@@ -1142,15 +2066,25 @@ const file_product_proto_rawDesc = "" +
 	"\bproducts\x18\x05 \x01(\v2$.product_service.GetProductsResponseH\x00R\bproducts\x12C\n" +
 	"\aproduct\x18\x06 \x01(\v2'.product_service.GetProductByIdResponseH\x00R\aproduct\x12P\n" +
 	"\x0eupdatedProduct\x18\a \x01(\v2&.product_service.UpdateProductResponseH\x00R\x0eupdatedProduct\x12Q\n" +
-	"\x0fdeleted_product\x18\b \x01(\v2&.product_service.DeleteProductResponseH\x00R\x0edeletedProductB\b\n" +
-	"\x06result2\x84\x05\n" +
+	"\x0fdeleted_product\x18\b \x01(\v2&.product_service.DeleteProductResponseH\x00R\x0edeletedProduct\x12Q\n" +
+	"\x10stock_adjustment\x18\t \x01(\v2$.product_service.AdjustStockResponseH\x00R\x0fstockAdjustment\x12\\\n" +
+	"\x11stock_adjustments\x18\n" +
+	" \x01(\v2-.product_service.ListStockAdjustmentsResponseH\x00R\x10stockAdjustments\x12@\n" +
+	"\vreservation\x18\v \x01(\v2\x1c.product_service.ReservationH\x00R\vreservation\x12R\n" +
+	"\x0ebatch_products\x18\f \x01(\v2).product_service.BatchGetProductsResponseH\x00R\rbatchProductsB\b\n" +
+	"\x06result2\xca\t\n" +
 	"\x0eProductService\x12o\n" +
 	"\rCreateProduct\x12%.product_service.CreateProductRequest\x1a!.product_service.StandardResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/products\x12g\n" +
 	"\n" +
 	"GetProduct\x12#.product_service.GetProductsRequest\x1a!.product_service.StandardResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/products\x12\x86\x01\n" +
 	"\x0eGetProductById\x12&.product_service.GetProductByIdRequest\x1a!.product_service.StandardResponse\")\x82\xd3\xe4\x93\x02#\x12!/products/{category}/{product_id}\x12\x87\x01\n" +
 	"\rUpdateProduct\x12%.product_service.UpdateProductRequest\x1a!.product_service.StandardResponse\",\x82\xd3\xe4\x93\x02&:\x01*2!/products/{category}/{product_id}\x12\x84\x01\n" +
-	"\rDeleteProduct\x12%.product_service.DeleteProductRequest\x1a!.product_service.StandardResponse\")\x82\xd3\xe4\x93\x02#*!/products/{category}/{product_id}B\xc6\x01\n" +
+	"\rDeleteProduct\x12%.product_service.DeleteProductRequest\x1a!.product_service.StandardResponse\")\x82\xd3\xe4\x93\x02#*!/products/{category}/{product_id}\x12\x89\x01\n" +
+	"\vAdjustStock\x12#.product_service.AdjustStockRequest\x1a!.product_service.StandardResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/products/{category}/{product_id}/stock\x12\xa4\x01\n" +
+	"\x14ListStockAdjustments\x12,.product_service.ListStockAdjustmentsRequest\x1a!.product_service.StandardResponse\";\x82\xd3\xe4\x93\x025\x123/products/{category}/{product_id}/stock/adjustments\x12W\n" +
+	"\fReserveStock\x12$.product_service.ReserveStockRequest\x1a!.product_service.StandardResponse\x12W\n" +
+	"\fReleaseStock\x12$.product_service.ReleaseStockRequest\x1a!.product_service.StandardResponse\x12_\n" +
+	"\x10BatchGetProducts\x12(.product_service.BatchGetProductsRequest\x1a!.product_service.StandardResponseB\xc6\x01\n" +
 	"\x13com.product_serviceB\fProductProtoP\x01ZIgithub.com/Likhon22/ecom_microservice/product_service/proto/gen;productpb\xa2\x02\x03PXX\xaa\x02\x0eProductService\xca\x02\x0eProductService\xe2\x02\x1aProductService\\GPBMetadata\xea\x02\x0eProductServiceb\x06proto3"
 
 var (
@@ -1165,45 +2099,78 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_product_proto_goTypes = []any{
-	(*CreateProductRequest)(nil),   // 0: product_service.CreateProductRequest
-	(*CreateProductResponse)(nil),  // 1: product_service.CreateProductResponse
-	(*Product)(nil),                // 2: product_service.Product
-	(*GetProductsRequest)(nil),     // 3: product_service.GetProductsRequest
-	(*GetProductsResponse)(nil),    // 4: product_service.GetProductsResponse
-	(*GetProductByIdRequest)(nil),  // 5: product_service.GetProductByIdRequest
-	(*GetProductByIdResponse)(nil), // 6: product_service.GetProductByIdResponse
-	(*UpdateProductRequest)(nil),   // 7: product_service.UpdateProductRequest
-	(*UpdateProductResponse)(nil),  // 8: product_service.UpdateProductResponse
-	(*DeleteProductRequest)(nil),   // 9: product_service.DeleteProductRequest
-	(*DeleteProductResponse)(nil),  // 10: product_service.DeleteProductResponse
-	(*StandardResponse)(nil),       // 11: product_service.StandardResponse
+	(*CreateProductRequest)(nil),         // 0: product_service.CreateProductRequest
+	(*CreateProductResponse)(nil),        // 1: product_service.CreateProductResponse
+	(*Product)(nil),                      // 2: product_service.Product
+	(*GetProductsRequest)(nil),           // 3: product_service.GetProductsRequest
+	(*GetProductsResponse)(nil),          // 4: product_service.GetProductsResponse
+	(*GetProductByIdRequest)(nil),        // 5: product_service.GetProductByIdRequest
+	(*GetProductByIdResponse)(nil),       // 6: product_service.GetProductByIdResponse
+	(*ProductKey)(nil),                   // 7: product_service.ProductKey
+	(*BatchGetProductsRequest)(nil),      // 8: product_service.BatchGetProductsRequest
+	(*BatchGetProductsResponse)(nil),     // 9: product_service.BatchGetProductsResponse
+	(*UpdateProductRequest)(nil),         // 10: product_service.UpdateProductRequest
+	(*UpdateProductResponse)(nil),        // 11: product_service.UpdateProductResponse
+	(*DeleteProductRequest)(nil),         // 12: product_service.DeleteProductRequest
+	(*DeleteProductResponse)(nil),        // 13: product_service.DeleteProductResponse
+	(*AdjustStockRequest)(nil),           // 14: product_service.AdjustStockRequest
+	(*StockAdjustment)(nil),              // 15: product_service.StockAdjustment
+	(*AdjustStockResponse)(nil),          // 16: product_service.AdjustStockResponse
+	(*ListStockAdjustmentsRequest)(nil),  // 17: product_service.ListStockAdjustmentsRequest
+	(*ListStockAdjustmentsResponse)(nil), // 18: product_service.ListStockAdjustmentsResponse
+	(*ReservationItem)(nil),              // 19: product_service.ReservationItem
+	(*ReserveStockRequest)(nil),          // 20: product_service.ReserveStockRequest
+	(*ReleaseStockRequest)(nil),          // 21: product_service.ReleaseStockRequest
+	(*Reservation)(nil),                  // 22: product_service.Reservation
+	(*StandardResponse)(nil),             // 23: product_service.StandardResponse
 }
 var file_product_proto_depIdxs = []int32{
 	2,  // 0: product_service.GetProductsResponse.products:type_name -> product_service.Product
 	2,  // 1: product_service.GetProductByIdResponse.product:type_name -> product_service.Product
-	2,  // 2: product_service.DeleteProductResponse.product:type_name -> product_service.Product
-	1,  // 3: product_service.StandardResponse.product_data:type_name -> product_service.CreateProductResponse
-	4,  // 4: product_service.StandardResponse.products:type_name -> product_service.GetProductsResponse
-	6,  // 5: product_service.StandardResponse.product:type_name -> product_service.GetProductByIdResponse
-	8,  // 6: product_service.StandardResponse.updatedProduct:type_name -> product_service.UpdateProductResponse
-	10, // 7: product_service.StandardResponse.deleted_product:type_name -> product_service.DeleteProductResponse
-	0,  // 8: product_service.ProductService.CreateProduct:input_type -> product_service.CreateProductRequest
-	3,  // 9: product_service.ProductService.GetProduct:input_type -> product_service.GetProductsRequest
-	5,  // 10: product_service.ProductService.GetProductById:input_type -> product_service.GetProductByIdRequest
-	7,  // 11: product_service.ProductService.UpdateProduct:input_type -> product_service.UpdateProductRequest
-	9,  // 12: product_service.ProductService.DeleteProduct:input_type -> product_service.DeleteProductRequest
-	11, // 13: product_service.ProductService.CreateProduct:output_type -> product_service.StandardResponse
-	11, // 14: product_service.ProductService.GetProduct:output_type -> product_service.StandardResponse
-	11, // 15: product_service.ProductService.GetProductById:output_type -> product_service.StandardResponse
-	11, // 16: product_service.ProductService.UpdateProduct:output_type -> product_service.StandardResponse
-	11, // 17: product_service.ProductService.DeleteProduct:output_type -> product_service.StandardResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	7,  // 2: product_service.BatchGetProductsRequest.products:type_name -> product_service.ProductKey
+	2,  // 3: product_service.BatchGetProductsResponse.products:type_name -> product_service.Product
+	2,  // 4: product_service.DeleteProductResponse.product:type_name -> product_service.Product
+	2,  // 5: product_service.AdjustStockResponse.product:type_name -> product_service.Product
+	15, // 6: product_service.AdjustStockResponse.adjustment:type_name -> product_service.StockAdjustment
+	15, // 7: product_service.ListStockAdjustmentsResponse.adjustments:type_name -> product_service.StockAdjustment
+	19, // 8: product_service.ReserveStockRequest.items:type_name -> product_service.ReservationItem
+	19, // 9: product_service.Reservation.items:type_name -> product_service.ReservationItem
+	1,  // 10: product_service.StandardResponse.product_data:type_name -> product_service.CreateProductResponse
+	4,  // 11: product_service.StandardResponse.products:type_name -> product_service.GetProductsResponse
+	6,  // 12: product_service.StandardResponse.product:type_name -> product_service.GetProductByIdResponse
+	11, // 13: product_service.StandardResponse.updatedProduct:type_name -> product_service.UpdateProductResponse
+	13, // 14: product_service.StandardResponse.deleted_product:type_name -> product_service.DeleteProductResponse
+	16, // 15: product_service.StandardResponse.stock_adjustment:type_name -> product_service.AdjustStockResponse
+	18, // 16: product_service.StandardResponse.stock_adjustments:type_name -> product_service.ListStockAdjustmentsResponse
+	22, // 17: product_service.StandardResponse.reservation:type_name -> product_service.Reservation
+	9,  // 18: product_service.StandardResponse.batch_products:type_name -> product_service.BatchGetProductsResponse
+	0,  // 19: product_service.ProductService.CreateProduct:input_type -> product_service.CreateProductRequest
+	3,  // 20: product_service.ProductService.GetProduct:input_type -> product_service.GetProductsRequest
+	5,  // 21: product_service.ProductService.GetProductById:input_type -> product_service.GetProductByIdRequest
+	10, // 22: product_service.ProductService.UpdateProduct:input_type -> product_service.UpdateProductRequest
+	12, // 23: product_service.ProductService.DeleteProduct:input_type -> product_service.DeleteProductRequest
+	14, // 24: product_service.ProductService.AdjustStock:input_type -> product_service.AdjustStockRequest
+	17, // 25: product_service.ProductService.ListStockAdjustments:input_type -> product_service.ListStockAdjustmentsRequest
+	20, // 26: product_service.ProductService.ReserveStock:input_type -> product_service.ReserveStockRequest
+	21, // 27: product_service.ProductService.ReleaseStock:input_type -> product_service.ReleaseStockRequest
+	8,  // 28: product_service.ProductService.BatchGetProducts:input_type -> product_service.BatchGetProductsRequest
+	23, // 29: product_service.ProductService.CreateProduct:output_type -> product_service.StandardResponse
+	23, // 30: product_service.ProductService.GetProduct:output_type -> product_service.StandardResponse
+	23, // 31: product_service.ProductService.GetProductById:output_type -> product_service.StandardResponse
+	23, // 32: product_service.ProductService.UpdateProduct:output_type -> product_service.StandardResponse
+	23, // 33: product_service.ProductService.DeleteProduct:output_type -> product_service.StandardResponse
+	23, // 34: product_service.ProductService.AdjustStock:output_type -> product_service.StandardResponse
+	23, // 35: product_service.ProductService.ListStockAdjustments:output_type -> product_service.StandardResponse
+	23, // 36: product_service.ProductService.ReserveStock:output_type -> product_service.StandardResponse
+	23, // 37: product_service.ProductService.ReleaseStock:output_type -> product_service.StandardResponse
+	23, // 38: product_service.ProductService.BatchGetProducts:output_type -> product_service.StandardResponse
+	29, // [29:39] is the sub-list for method output_type
+	19, // [19:29] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -1211,13 +2178,17 @@ func file_product_proto_init() {
 	if File_product_proto != nil {
 		return
 	}
-	file_product_proto_msgTypes[7].OneofWrappers = []any{}
-	file_product_proto_msgTypes[11].OneofWrappers = []any{
+	file_product_proto_msgTypes[10].OneofWrappers = []any{}
+	file_product_proto_msgTypes[23].OneofWrappers = []any{
 		(*StandardResponse_ProductData)(nil),
 		(*StandardResponse_Products)(nil),
 		(*StandardResponse_Product)(nil),
 		(*StandardResponse_UpdatedProduct)(nil),
 		(*StandardResponse_DeletedProduct)(nil),
+		(*StandardResponse_StockAdjustment)(nil),
+		(*StandardResponse_StockAdjustments)(nil),
+		(*StandardResponse_Reservation)(nil),
+		(*StandardResponse_BatchProducts)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1225,7 +2196,7 @@ func file_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
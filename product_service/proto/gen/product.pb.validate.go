@@ -137,6 +137,17 @@ func (m *CreateProductRequest) validate(all bool) error {
 
 	}
 
+	if m.GetStock() < 0 {
+		err := CreateProductRequestValidationError{
+			field:  "Stock",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateProductRequestMultiError(errors)
 	}
@@ -253,6 +264,12 @@ func (m *CreateProductResponse) validate(all bool) error {
 
 	// no validation rules for IsFeatured
 
+	// no validation rules for StockOnHand
+
+	// no validation rules for Reserved
+
+	// no validation rules for Available
+
 	if len(errors) > 0 {
 		return CreateProductResponseMultiError(errors)
 	}
@@ -370,6 +387,12 @@ func (m *Product) validate(all bool) error {
 
 	// no validation rules for CreatedBy
 
+	// no validation rules for StockOnHand
+
+	// no validation rules for Reserved
+
+	// no validation rules for Available
+
 	if len(errors) > 0 {
 		return ProductMultiError(errors)
 	}
@@ -928,22 +951,22 @@ var _ interface {
 	ErrorName() string
 } = GetProductByIdResponseValidationError{}
 
-// Validate checks the field values on UpdateProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProductRequest) Validate() error {
+// Validate checks the field values on ProductKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProductKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateProductRequestMultiError, or nil if none found.
-func (m *UpdateProductRequest) ValidateAll() error {
+// ValidateAll checks the field values on ProductKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProductKeyMultiError, or
+// nil if none found.
+func (m *ProductKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProductRequest) validate(all bool) error {
+func (m *ProductKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -951,7 +974,7 @@ func (m *UpdateProductRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetCategory()) < 1 {
-		err := UpdateProductRequestValidationError{
+		err := ProductKeyValidationError{
 			field:  "Category",
 			reason: "value length must be at least 1 runes",
 		}
@@ -962,7 +985,7 @@ func (m *UpdateProductRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProductId()) < 1 {
-		err := UpdateProductRequestValidationError{
+		err := ProductKeyValidationError{
 			field:  "ProductId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -972,131 +995,19 @@ func (m *UpdateProductRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetTags() {
-		_, _ = idx, item
-
-		if l := utf8.RuneCountInString(item); l < 1 || l > 30 {
-			err := UpdateProductRequestValidationError{
-				field:  fmt.Sprintf("Tags[%v]", idx),
-				reason: "value length must be between 1 and 30 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	for idx, item := range m.GetImageUrls() {
-		_, _ = idx, item
-
-		if l := utf8.RuneCountInString(item); l < 1 || l > 200 {
-			err := UpdateProductRequestValidationError{
-				field:  fmt.Sprintf("ImageUrls[%v]", idx),
-				reason: "value length must be between 1 and 200 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.Name != nil {
-
-		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
-			err := UpdateProductRequestValidationError{
-				field:  "Name",
-				reason: "value length must be between 1 and 100 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.Description != nil {
-
-		if utf8.RuneCountInString(m.GetDescription()) > 500 {
-			err := UpdateProductRequestValidationError{
-				field:  "Description",
-				reason: "value length must be at most 500 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.NewCategory != nil {
-
-		if l := utf8.RuneCountInString(m.GetNewCategory()); l < 1 || l > 50 {
-			err := UpdateProductRequestValidationError{
-				field:  "NewCategory",
-				reason: "value length must be between 1 and 50 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.Price != nil {
-
-		if m.GetPrice() < 0 {
-			err := UpdateProductRequestValidationError{
-				field:  "Price",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.IsFeatured != nil {
-		// no validation rules for IsFeatured
-	}
-
-	if m.Status != nil {
-
-		if utf8.RuneCountInString(m.GetStatus()) < 1 {
-			err := UpdateProductRequestValidationError{
-				field:  "Status",
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return UpdateProductRequestMultiError(errors)
+		return ProductKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProductRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProductRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateProductRequestMultiError []error
+// ProductKeyMultiError is an error wrapping multiple validation errors
+// returned by ProductKey.ValidateAll() if the designated constraints aren't met.
+type ProductKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProductRequestMultiError) Error() string {
+func (m ProductKeyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1105,11 +1016,11 @@ func (m UpdateProductRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProductRequestMultiError) AllErrors() []error { return m }
+func (m ProductKeyMultiError) AllErrors() []error { return m }
 
-// UpdateProductRequestValidationError is the validation error returned by
-// UpdateProductRequest.Validate if the designated constraints aren't met.
-type UpdateProductRequestValidationError struct {
+// ProductKeyValidationError is the validation error returned by
+// ProductKey.Validate if the designated constraints aren't met.
+type ProductKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1117,24 +1028,22 @@ type UpdateProductRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProductRequestValidationError) Field() string { return e.field }
+func (e ProductKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProductRequestValidationError) Reason() string { return e.reason }
+func (e ProductKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProductRequestValidationError) Cause() error { return e.cause }
+func (e ProductKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProductRequestValidationError) Key() bool { return e.key }
+func (e ProductKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProductRequestValidationError) ErrorName() string {
-	return "UpdateProductRequestValidationError"
-}
+func (e ProductKeyValidationError) ErrorName() string { return "ProductKeyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateProductRequestValidationError) Error() string {
+func (e ProductKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1146,14 +1055,14 @@ func (e UpdateProductRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProductRequest.%s: %s%s",
+		"invalid %sProductKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProductRequestValidationError{}
+var _ error = ProductKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1161,60 +1070,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProductRequestValidationError{}
+} = ProductKeyValidationError{}
 
-// Validate checks the field values on UpdateProductResponse with the rules
+// Validate checks the field values on BatchGetProductsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProductResponse) Validate() error {
+func (m *BatchGetProductsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProductResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProductResponseMultiError, or nil if none found.
-func (m *UpdateProductResponse) ValidateAll() error {
+// BatchGetProductsRequestMultiError, or nil if none found.
+func (m *BatchGetProductsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProductResponse) validate(all bool) error {
+func (m *BatchGetProductsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProductId
-
-	// no validation rules for Name
-
-	// no validation rules for Description
-
-	// no validation rules for Category
-
-	// no validation rules for Price
+	if l := len(m.GetProducts()); l < 1 || l > 100 {
+		err := BatchGetProductsRequestValidationError{
+			field:  "Products",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Status
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
 
-	// no validation rules for IsFeatured
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetProductsRequestValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetProductsRequestValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetProductsRequestValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for UpdatedAt
+	}
 
 	if len(errors) > 0 {
-		return UpdateProductResponseMultiError(errors)
+		return BatchGetProductsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProductResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateProductResponse.ValidateAll() if the designated
+// BatchGetProductsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProductsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProductResponseMultiError []error
+type BatchGetProductsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProductResponseMultiError) Error() string {
+func (m BatchGetProductsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1223,11 +1161,11 @@ func (m UpdateProductResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProductResponseMultiError) AllErrors() []error { return m }
+func (m BatchGetProductsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProductResponseValidationError is the validation error returned by
-// UpdateProductResponse.Validate if the designated constraints aren't met.
-type UpdateProductResponseValidationError struct {
+// BatchGetProductsRequestValidationError is the validation error returned by
+// BatchGetProductsRequest.Validate if the designated constraints aren't met.
+type BatchGetProductsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1235,24 +1173,24 @@ type UpdateProductResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProductResponseValidationError) Field() string { return e.field }
+func (e BatchGetProductsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProductResponseValidationError) Reason() string { return e.reason }
+func (e BatchGetProductsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProductResponseValidationError) Cause() error { return e.cause }
+func (e BatchGetProductsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProductResponseValidationError) Key() bool { return e.key }
+func (e BatchGetProductsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProductResponseValidationError) ErrorName() string {
-	return "UpdateProductResponseValidationError"
+func (e BatchGetProductsRequestValidationError) ErrorName() string {
+	return "BatchGetProductsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProductResponseValidationError) Error() string {
+func (e BatchGetProductsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1264,14 +1202,14 @@ func (e UpdateProductResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProductResponse.%s: %s%s",
+		"invalid %sBatchGetProductsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProductResponseValidationError{}
+var _ error = BatchGetProductsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1279,48 +1217,1798 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProductResponseValidationError{}
+} = BatchGetProductsRequestValidationError{}
 
-// Validate checks the field values on DeleteProductRequest with the rules
+// Validate checks the field values on BatchGetProductsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProductRequest) Validate() error {
+func (m *BatchGetProductsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProductRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProductRequestMultiError, or nil if none found.
-func (m *DeleteProductRequest) ValidateAll() error {
+// BatchGetProductsResponseMultiError, or nil if none found.
+func (m *BatchGetProductsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProductRequest) validate(all bool) error {
+func (m *BatchGetProductsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProductId
-
-	// no validation rules for Category
-
-	if len(errors) > 0 {
-		return DeleteProductRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// DeleteProductRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProductRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProductRequestMultiError []error
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetProductsResponseValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetProductsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetProductsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetProductsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetProductsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetProductsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetProductsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetProductsResponseValidationError is the validation error returned by
+// BatchGetProductsResponse.Validate if the designated constraints aren't met.
+type BatchGetProductsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetProductsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetProductsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetProductsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetProductsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetProductsResponseValidationError) ErrorName() string {
+	return "BatchGetProductsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetProductsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetProductsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetProductsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetProductsResponseValidationError{}
+
+// Validate checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProductRequestMultiError, or nil if none found.
+func (m *UpdateProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCategory()) < 1 {
+		err := UpdateProductRequestValidationError{
+			field:  "Category",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProductId()) < 1 {
+		err := UpdateProductRequestValidationError{
+			field:  "ProductId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 30 {
+			err := UpdateProductRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 30 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetImageUrls() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 200 {
+			err := UpdateProductRequestValidationError{
+				field:  fmt.Sprintf("ImageUrls[%v]", idx),
+				reason: "value length must be between 1 and 200 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Name != nil {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+			err := UpdateProductRequestValidationError{
+				field:  "Name",
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 500 {
+			err := UpdateProductRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 500 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.NewCategory != nil {
+
+		if l := utf8.RuneCountInString(m.GetNewCategory()); l < 1 || l > 50 {
+			err := UpdateProductRequestValidationError{
+				field:  "NewCategory",
+				reason: "value length must be between 1 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Price != nil {
+
+		if m.GetPrice() < 0 {
+			err := UpdateProductRequestValidationError{
+				field:  "Price",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.IsFeatured != nil {
+		// no validation rules for IsFeatured
+	}
+
+	if m.Status != nil {
+
+		if utf8.RuneCountInString(m.GetStatus()) < 1 {
+			err := UpdateProductRequestValidationError{
+				field:  "Status",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProductRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProductRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProductRequestValidationError is the validation error returned by
+// UpdateProductRequest.Validate if the designated constraints aren't met.
+type UpdateProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProductRequestValidationError) ErrorName() string {
+	return "UpdateProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProductRequestValidationError{}
+
+// Validate checks the field values on UpdateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProductResponseMultiError, or nil if none found.
+func (m *UpdateProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Category
+
+	// no validation rules for Price
+
+	// no validation rules for Status
+
+	// no validation rules for IsFeatured
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return UpdateProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProductResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProductResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProductResponseValidationError is the validation error returned by
+// UpdateProductResponse.Validate if the designated constraints aren't met.
+type UpdateProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProductResponseValidationError) ErrorName() string {
+	return "UpdateProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProductResponseValidationError{}
+
+// Validate checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductRequestMultiError, or nil if none found.
+func (m *DeleteProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductId
+
+	// no validation rules for Category
+
+	if len(errors) > 0 {
+		return DeleteProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProductRequestValidationError is the validation error returned by
+// DeleteProductRequest.Validate if the designated constraints aren't met.
+type DeleteProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductRequestValidationError) ErrorName() string {
+	return "DeleteProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductRequestValidationError{}
+
+// Validate checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductResponseMultiError, or nil if none found.
+func (m *DeleteProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProductResponseValidationError is the validation error returned by
+// DeleteProductResponse.Validate if the designated constraints aren't met.
+type DeleteProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductResponseValidationError) ErrorName() string {
+	return "DeleteProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductResponseValidationError{}
+
+// Validate checks the field values on AdjustStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustStockRequestMultiError, or nil if none found.
+func (m *AdjustStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCategory()) < 1 {
+		err := AdjustStockRequestValidationError{
+			field:  "Category",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProductId()) < 1 {
+		err := AdjustStockRequestValidationError{
+			field:  "ProductId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdjustStockRequest_Type_InLookup[m.GetType()]; !ok {
+		err := AdjustStockRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [restock correction]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() < 0 {
+		err := AdjustStockRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := AdjustStockRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdjustStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustStockRequestMultiError is an error wrapping multiple validation errors
+// returned by AdjustStockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustStockRequestMultiError) AllErrors() []error { return m }
+
+// AdjustStockRequestValidationError is the validation error returned by
+// AdjustStockRequest.Validate if the designated constraints aren't met.
+type AdjustStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustStockRequestValidationError) ErrorName() string {
+	return "AdjustStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustStockRequestValidationError{}
+
+var _AdjustStockRequest_Type_InLookup = map[string]struct{}{
+	"restock":    {},
+	"correction": {},
+}
+
+// Validate checks the field values on StockAdjustment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StockAdjustment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockAdjustment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockAdjustmentMultiError, or nil if none found.
+func (m *StockAdjustment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockAdjustment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AdjustmentId
+
+	// no validation rules for ProductId
+
+	// no validation rules for Category
+
+	// no validation rules for Type
+
+	// no validation rules for PreviousOnHand
+
+	// no validation rules for NewOnHand
+
+	// no validation rules for Delta
+
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return StockAdjustmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockAdjustmentMultiError is an error wrapping multiple validation errors
+// returned by StockAdjustment.ValidateAll() if the designated constraints
+// aren't met.
+type StockAdjustmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockAdjustmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockAdjustmentMultiError) AllErrors() []error { return m }
+
+// StockAdjustmentValidationError is the validation error returned by
+// StockAdjustment.Validate if the designated constraints aren't met.
+type StockAdjustmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockAdjustmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockAdjustmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockAdjustmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockAdjustmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockAdjustmentValidationError) ErrorName() string { return "StockAdjustmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockAdjustmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockAdjustment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockAdjustmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockAdjustmentValidationError{}
+
+// Validate checks the field values on AdjustStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustStockResponseMultiError, or nil if none found.
+func (m *AdjustStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustStockResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustStockResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustStockResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdjustment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustStockResponseValidationError{
+					field:  "Adjustment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustStockResponseValidationError{
+					field:  "Adjustment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdjustment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustStockResponseValidationError{
+				field:  "Adjustment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdjustStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustStockResponseMultiError is an error wrapping multiple validation
+// errors returned by AdjustStockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustStockResponseMultiError) AllErrors() []error { return m }
+
+// AdjustStockResponseValidationError is the validation error returned by
+// AdjustStockResponse.Validate if the designated constraints aren't met.
+type AdjustStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustStockResponseValidationError) ErrorName() string {
+	return "AdjustStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustStockResponseValidationError{}
+
+// Validate checks the field values on ListStockAdjustmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStockAdjustmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStockAdjustmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStockAdjustmentsRequestMultiError, or nil if none found.
+func (m *ListStockAdjustmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStockAdjustmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCategory()) < 1 {
+		err := ListStockAdjustmentsRequestValidationError{
+			field:  "Category",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProductId()) < 1 {
+		err := ListStockAdjustmentsRequestValidationError{
+			field:  "ProductId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListStockAdjustmentsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStockAdjustmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStockAdjustmentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListStockAdjustmentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListStockAdjustmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStockAdjustmentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStockAdjustmentsRequestMultiError) AllErrors() []error { return m }
+
+// ListStockAdjustmentsRequestValidationError is the validation error returned
+// by ListStockAdjustmentsRequest.Validate if the designated constraints
+// aren't met.
+type ListStockAdjustmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStockAdjustmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStockAdjustmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStockAdjustmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStockAdjustmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStockAdjustmentsRequestValidationError) ErrorName() string {
+	return "ListStockAdjustmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStockAdjustmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStockAdjustmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStockAdjustmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStockAdjustmentsRequestValidationError{}
+
+// Validate checks the field values on ListStockAdjustmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStockAdjustmentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStockAdjustmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStockAdjustmentsResponseMultiError, or nil if none found.
+func (m *ListStockAdjustmentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStockAdjustmentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAdjustments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStockAdjustmentsResponseValidationError{
+						field:  fmt.Sprintf("Adjustments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStockAdjustmentsResponseValidationError{
+						field:  fmt.Sprintf("Adjustments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStockAdjustmentsResponseValidationError{
+					field:  fmt.Sprintf("Adjustments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStockAdjustmentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStockAdjustmentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListStockAdjustmentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListStockAdjustmentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStockAdjustmentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStockAdjustmentsResponseMultiError) AllErrors() []error { return m }
+
+// ListStockAdjustmentsResponseValidationError is the validation error returned
+// by ListStockAdjustmentsResponse.Validate if the designated constraints
+// aren't met.
+type ListStockAdjustmentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStockAdjustmentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStockAdjustmentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStockAdjustmentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStockAdjustmentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStockAdjustmentsResponseValidationError) ErrorName() string {
+	return "ListStockAdjustmentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStockAdjustmentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStockAdjustmentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStockAdjustmentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStockAdjustmentsResponseValidationError{}
+
+// Validate checks the field values on ReservationItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReservationItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservationItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservationItemMultiError, or nil if none found.
+func (m *ReservationItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservationItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProductId()) < 1 {
+		err := ReservationItemValidationError{
+			field:  "ProductId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCategory()) < 1 {
+		err := ReservationItemValidationError{
+			field:  "Category",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := ReservationItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReservationItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservationItemMultiError is an error wrapping multiple validation errors
+// returned by ReservationItem.ValidateAll() if the designated constraints
+// aren't met.
+type ReservationItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservationItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservationItemMultiError) AllErrors() []error { return m }
+
+// ReservationItemValidationError is the validation error returned by
+// ReservationItem.Validate if the designated constraints aren't met.
+type ReservationItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservationItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservationItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservationItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservationItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservationItemValidationError) ErrorName() string { return "ReservationItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReservationItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservationItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservationItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservationItemValidationError{}
+
+// Validate checks the field values on ReserveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReserveStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReserveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReserveStockRequestMultiError, or nil if none found.
+func (m *ReserveStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReserveStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetReservationId()); l < 1 || l > 100 {
+		err := ReserveStockRequestValidationError{
+			field:  "ReservationId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetItems()); l < 1 || l > 99 {
+		err := ReserveStockRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 99 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReserveStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReserveStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReserveStockRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if val := m.GetTtlSeconds(); val < 0 || val > 86400 {
+		err := ReserveStockRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be inside range [0, 86400]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReserveStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReserveStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ReserveStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReserveStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReserveStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReserveStockRequestMultiError) AllErrors() []error { return m }
+
+// ReserveStockRequestValidationError is the validation error returned by
+// ReserveStockRequest.Validate if the designated constraints aren't met.
+type ReserveStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReserveStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReserveStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReserveStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReserveStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReserveStockRequestValidationError) ErrorName() string {
+	return "ReserveStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReserveStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReserveStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReserveStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReserveStockRequestValidationError{}
+
+// Validate checks the field values on ReleaseStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseStockRequestMultiError, or nil if none found.
+func (m *ReleaseStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetReservationId()) < 1 {
+		err := ReleaseStockRequestValidationError{
+			field:  "ReservationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseStockRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProductRequestMultiError) Error() string {
+func (m ReleaseStockRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1329,11 +3017,11 @@ func (m DeleteProductRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+func (m ReleaseStockRequestMultiError) AllErrors() []error { return m }
 
-// DeleteProductRequestValidationError is the validation error returned by
-// DeleteProductRequest.Validate if the designated constraints aren't met.
-type DeleteProductRequestValidationError struct {
+// ReleaseStockRequestValidationError is the validation error returned by
+// ReleaseStockRequest.Validate if the designated constraints aren't met.
+type ReleaseStockRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1341,24 +3029,24 @@ type DeleteProductRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProductRequestValidationError) Field() string { return e.field }
+func (e ReleaseStockRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+func (e ReleaseStockRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+func (e ReleaseStockRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+func (e ReleaseStockRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProductRequestValidationError) ErrorName() string {
-	return "DeleteProductRequestValidationError"
+func (e ReleaseStockRequestValidationError) ErrorName() string {
+	return "ReleaseStockRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProductRequestValidationError) Error() string {
+func (e ReleaseStockRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1370,14 +3058,14 @@ func (e DeleteProductRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProductRequest.%s: %s%s",
+		"invalid %sReleaseStockRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProductRequestValidationError{}
+var _ error = ReleaseStockRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1385,73 +3073,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProductRequestValidationError{}
+} = ReleaseStockRequestValidationError{}
 
-// Validate checks the field values on DeleteProductResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProductResponse) Validate() error {
+// Validate checks the field values on Reservation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reservation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProductResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteProductResponseMultiError, or nil if none found.
-func (m *DeleteProductResponse) ValidateAll() error {
+// ValidateAll checks the field values on Reservation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReservationMultiError, or
+// nil if none found.
+func (m *Reservation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProductResponse) validate(all bool) error {
+func (m *Reservation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetProduct()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeleteProductResponseValidationError{
-					field:  "Product",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for ReservationId
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReservationValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReservationValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeleteProductResponseValidationError{
-					field:  "Product",
+				return ReservationValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DeleteProductResponseValidationError{
-				field:  "Product",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Status
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for CreatedAt
+
 	if len(errors) > 0 {
-		return DeleteProductResponseMultiError(errors)
+		return ReservationMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProductResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteProductResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProductResponseMultiError []error
+// ReservationMultiError is an error wrapping multiple validation errors
+// returned by Reservation.ValidateAll() if the designated constraints aren't met.
+type ReservationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProductResponseMultiError) Error() string {
+func (m ReservationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1460,11 +3160,11 @@ func (m DeleteProductResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProductResponseMultiError) AllErrors() []error { return m }
+func (m ReservationMultiError) AllErrors() []error { return m }
 
-// DeleteProductResponseValidationError is the validation error returned by
-// DeleteProductResponse.Validate if the designated constraints aren't met.
-type DeleteProductResponseValidationError struct {
+// ReservationValidationError is the validation error returned by
+// Reservation.Validate if the designated constraints aren't met.
+type ReservationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1472,24 +3172,22 @@ type DeleteProductResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProductResponseValidationError) Field() string { return e.field }
+func (e ReservationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProductResponseValidationError) Reason() string { return e.reason }
+func (e ReservationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProductResponseValidationError) Cause() error { return e.cause }
+func (e ReservationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProductResponseValidationError) Key() bool { return e.key }
+func (e ReservationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProductResponseValidationError) ErrorName() string {
-	return "DeleteProductResponseValidationError"
-}
+func (e ReservationValidationError) ErrorName() string { return "ReservationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteProductResponseValidationError) Error() string {
+func (e ReservationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1501,14 +3199,14 @@ func (e DeleteProductResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProductResponse.%s: %s%s",
+		"invalid %sReservation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProductResponseValidationError{}
+var _ error = ReservationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1516,7 +3214,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProductResponseValidationError{}
+} = ReservationValidationError{}
 
 // Validate checks the field values on StandardResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -1752,6 +3450,170 @@ func (m *StandardResponse) validate(all bool) error {
 			}
 		}
 
+	case *StandardResponse_StockAdjustment:
+		if v == nil {
+			err := StandardResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetStockAdjustment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "StockAdjustment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "StockAdjustment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStockAdjustment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StandardResponseValidationError{
+					field:  "StockAdjustment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StandardResponse_StockAdjustments:
+		if v == nil {
+			err := StandardResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetStockAdjustments()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "StockAdjustments",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "StockAdjustments",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStockAdjustments()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StandardResponseValidationError{
+					field:  "StockAdjustments",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StandardResponse_Reservation:
+		if v == nil {
+			err := StandardResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetReservation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "Reservation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "Reservation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReservation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StandardResponseValidationError{
+					field:  "Reservation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StandardResponse_BatchProducts:
+		if v == nil {
+			err := StandardResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBatchProducts()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "BatchProducts",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StandardResponseValidationError{
+						field:  "BatchProducts",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBatchProducts()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StandardResponseValidationError{
+					field:  "BatchProducts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}